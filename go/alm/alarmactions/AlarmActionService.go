@@ -0,0 +1,87 @@
+package alarmactions
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+)
+
+const (
+	ServiceName = "AlmAction"
+	ServiceArea = byte(10)
+)
+
+// AlarmActionService applies operator actions to alarms. A POSTed
+// AlarmActionRequest acknowledges, unacknowledges, clears, assigns or adds a
+// note to its alarms server-side and returns the updated alarms in an
+// AlarmActionResponse. A request with a query acts on every matching alarm
+// and reports the outcome of each.
+type AlarmActionService struct {
+	serviceName string
+	serviceArea byte
+}
+
+func Activate(vnic ifs.IVNic) {
+	svc := &AlarmActionService{}
+	sla := ifs.NewServiceLevelAgreement(svc, ServiceName, ServiceArea, true, nil)
+	sla.SetServiceItem(&alm.AlarmActionRequest{})
+	sla.SetServiceItemList(&alm.AlarmActionResponse{})
+
+	ws := web.New(ServiceName, ServiceArea, 0)
+	ws.AddEndpoint(&alm.AlarmActionRequest{}, ifs.POST, &alm.AlarmActionResponse{})
+	sla.SetWebService(ws)
+
+	vnic.Resources().Services().Activate(sla, vnic)
+}
+
+func (s *AlarmActionService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	s.serviceName = sla.ServiceName()
+	s.serviceArea = sla.ServiceArea()
+	return nil
+}
+
+func (s *AlarmActionService) DeActivate() error { return nil }
+
+// Post applies the action of the request to its alarm_ids or query matches.
+func (s *AlarmActionService) Post(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	req, ok := elements.Element().(*alm.AlarmActionRequest)
+	if !ok || req == nil {
+		return object.NewError("invalid request: expected AlarmActionRequest")
+	}
+	resp, err := Run(req, vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, resp)
+}
+
+func (s *AlarmActionService) Get(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("alarm action service only supports POST")
+}
+
+func (s *AlarmActionService) Put(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("alarm action service only supports POST")
+}
+
+func (s *AlarmActionService) Patch(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("alarm action service only supports POST")
+}
+
+func (s *AlarmActionService) Delete(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("alarm action service only supports POST")
+}
+
+func (s *AlarmActionService) Failed(elements ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (s *AlarmActionService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (s *AlarmActionService) WebService() ifs.IWebService {
+	ws := web.New(s.serviceName, s.serviceArea, 0)
+	ws.AddEndpoint(&alm.AlarmActionRequest{}, ifs.POST, &alm.AlarmActionResponse{})
+	return ws
+}
@@ -0,0 +1,205 @@
+package alarmactions
+
+import (
+	"errors"
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/alarms"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8types/go/types/l8api"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// Run applies the action of a request to its alarm_ids or to the alarms
+// matching its query. A dry run reports the outcome without writing.
+func Run(req *alm.AlarmActionRequest, vnic ifs.IVNic) (*alm.AlarmActionResponse, error) {
+	if err := Validate(req); err != nil {
+		return nil, err
+	}
+	if (len(req.AlarmIds) == 0) == (req.Query == "") {
+		return nil, errors.New("invalid request: exactly one of alarmIds or query is required")
+	}
+	if req.Query != "" {
+		return runQuery(req, vnic)
+	}
+	if len(req.AlarmIds) > common.ALARM_ACTION_MAX_ALARMS {
+		return nil, fmt.Errorf("invalid request: %d alarms, more than the maximum of %d",
+			len(req.AlarmIds), common.ALARM_ACTION_MAX_ALARMS)
+	}
+	return runIds(req, vnic)
+}
+
+// runIds first applies the action to copies of all alarms, so a request with
+// any missing alarm or invalid transition changes none of them. Each alarm is
+// then changed on its own through alarms.Update, which re-reads it: an alarm
+// that changed meanwhile so the action no longer applies, or whose write
+// fails, is reported in the results and the others still change.
+func runIds(req *alm.AlarmActionRequest, vnic ifs.IVNic) (*alm.AlarmActionResponse, error) {
+	now := time.Now().Unix()
+	resp := &alm.AlarmActionResponse{DryRun: req.DryRun}
+	for _, id := range req.AlarmIds {
+		alarm, err := alarms.GetAlarm(id, vnic)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", id, err)
+		}
+		if alarm == nil {
+			return nil, fmt.Errorf("%s: alarm not found", id)
+		}
+		alarm = proto.Clone(alarm).(*alm.Alarm)
+		if err := Apply(alarm, req, now); err != nil {
+			return nil, fmt.Errorf("%s: %w", id, err)
+		}
+		resp.Alarms = append(resp.Alarms, alarm)
+	}
+	if req.DryRun {
+		return resp, nil
+	}
+
+	resp.Alarms = nil
+	for _, id := range req.AlarmIds {
+		update(resp, id, req, now, vnic)
+	}
+	return resp, nil
+}
+
+// runQuery applies the action to each alarm matching the query on its own:
+// an alarm whose transition is invalid or whose write fails is reported in
+// the results and the others still change. The query is limited to one alarm
+// over the maximum, enough to tell it matches too many without loading them
+// all; a lower limit of its own is kept.
+func runQuery(req *alm.AlarmActionRequest, vnic ifs.IVNic) (*alm.AlarmActionResponse, error) {
+	elems, err := object.NewQuery(req.Query, vnic.Resources())
+	if err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	query, ok := elems.Element().(*l8api.L8Query)
+	if !ok {
+		return nil, errors.New("invalid request: query could not be parsed")
+	}
+	if limit := int32(common.ALARM_ACTION_MAX_ALARMS + 1); query.Limit <= 0 || query.Limit > limit {
+		query.Limit = limit
+	}
+
+	handler, ok := alarms.Alarms(vnic)
+	if !ok {
+		return nil, errors.New("alarm service is not available")
+	}
+	result := handler.Get(elems, vnic)
+	if result == nil {
+		return nil, errors.New("failed to query alarms: no response")
+	}
+	if result.Error() != nil {
+		return nil, fmt.Errorf("failed to query alarms: %w", result.Error())
+	}
+	alarmsRaw := result.Elements()
+	if len(alarmsRaw) > common.ALARM_ACTION_MAX_ALARMS {
+		return nil, fmt.Errorf("query matches %d alarms, more than the maximum of %d",
+			len(alarmsRaw), common.ALARM_ACTION_MAX_ALARMS)
+	}
+
+	now := time.Now().Unix()
+	resp := &alm.AlarmActionResponse{DryRun: req.DryRun}
+	for _, raw := range alarmsRaw {
+		alarm, ok := raw.(*alm.Alarm)
+		if !ok {
+			addResult(resp, "", nil, fmt.Errorf("query returned a %T, not an alarm", raw))
+			continue
+		}
+		if !req.DryRun {
+			update(resp, alarm.AlarmId, req, now, vnic)
+			continue
+		}
+		alarm = proto.Clone(alarm).(*alm.Alarm)
+		err := Apply(alarm, req, now)
+		addResult(resp, alarm.AlarmId, alarm, err)
+	}
+	return resp, nil
+}
+
+// update applies the action to an alarm through alarms.Update, as made by
+// the request's user, and adds the outcome to the response.
+func update(resp *alm.AlarmActionResponse, alarmId string, req *alm.AlarmActionRequest, now int64, vnic ifs.IVNic) {
+	alarm, err := alarms.Update(alarmId, req.User, req.Reason, func(alarm *alm.Alarm) error {
+		return Apply(alarm, req, now)
+	}, vnic)
+	addResult(resp, alarmId, alarm, err)
+}
+
+func addResult(resp *alm.AlarmActionResponse, alarmId string, alarm *alm.Alarm, err error) {
+	result := &alm.AlarmActionResult{AlarmId: alarmId, Success: err == nil}
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		resp.Alarms = append(resp.Alarms, alarm)
+	}
+	resp.Results = append(resp.Results, result)
+}
+
+// Validate checks the action, user and note of a request.
+func Validate(req *alm.AlarmActionRequest) error {
+	if req.Action == alm.AlarmActionType_ALARM_ACTION_TYPE_UNSPECIFIED {
+		return errors.New("invalid request: action is required")
+	}
+	if _, ok := alm.AlarmActionType_name[int32(req.Action)]; !ok {
+		return fmt.Errorf("invalid request: unknown action %d", req.Action)
+	}
+	if req.User == "" {
+		return errors.New("invalid request: user is required")
+	}
+	if req.Action == alm.AlarmActionType_ALARM_ACTION_TYPE_ADD_NOTE && req.Note == "" {
+		return errors.New("invalid request: note is required to add a note")
+	}
+	return nil
+}
+
+// Apply applies the action of a request to an alarm in memory. State changes
+// go through common.TransitionAlarm, recorded as made by the request's user;
+// a note, if any, is added as an AlarmNote by the user.
+func Apply(alarm *alm.Alarm, req *alm.AlarmActionRequest, now int64) error {
+	switch req.Action {
+	case alm.AlarmActionType_ALARM_ACTION_TYPE_ACKNOWLEDGE:
+		if err := transition(alarm, l8events.AlarmState_ALARM_STATE_ACKNOWLEDGED, req); err != nil {
+			return err
+		}
+	case alm.AlarmActionType_ALARM_ACTION_TYPE_UNACKNOWLEDGE:
+		if alarm.State != l8events.AlarmState_ALARM_STATE_ACKNOWLEDGED {
+			return fmt.Errorf("alarm is %s, not acknowledged", alarm.State.String())
+		}
+		if err := transition(alarm, l8events.AlarmState_ALARM_STATE_ACTIVE, req); err != nil {
+			return err
+		}
+		alarm.AcknowledgedBy = ""
+		alarm.AcknowledgedAt = 0
+	case alm.AlarmActionType_ALARM_ACTION_TYPE_CLEAR:
+		if err := transition(alarm, l8events.AlarmState_ALARM_STATE_CLEARED, req); err != nil {
+			return err
+		}
+	case alm.AlarmActionType_ALARM_ACTION_TYPE_ASSIGN:
+		alarm.AssignedTo = req.Assignee
+		alarm.AssignedAt = now
+		if req.Assignee == "" {
+			alarm.AssignedAt = 0
+		}
+	}
+
+	if req.Note != "" {
+		alarm.Notes = append(alarm.Notes, &l8events.AlarmNote{
+			NoteId:    ifs.NewUuid(),
+			Author:    req.User,
+			Text:      req.Note,
+			CreatedAt: now,
+		})
+	}
+	return nil
+}
+
+func transition(alarm *alm.Alarm, state l8events.AlarmState, req *alm.AlarmActionRequest) error {
+	if alarm.State == state {
+		return fmt.Errorf("alarm is already %s", state.String())
+	}
+	return common.TransitionAlarm(alarm, state, req.User, common.SourceOperator, req.Reason)
+}
@@ -1,6 +1,7 @@
 package alarmdefinitions
 
 import (
+	"github.com/saichler/l8alarms/go/alm/dedup"
 	"github.com/saichler/l8alarms/go/types/alm"
 	"github.com/saichler/l8common/go/common"
 	l8events "github.com/saichler/l8types/go/types/l8events"
@@ -13,5 +14,15 @@ func newAlarmDefinitionServiceCallback(vnic ifs.IVNic) ifs.IServiceCallback {
 		Require(func(e interface{}) string { return e.(*alm.AlarmDefinition).Name }, "Name").
 		Enum(func(e interface{}) int32 { return int32(e.(*alm.AlarmDefinition).Status) }, alm.AlarmDefinitionStatus_name, "Status").
 		Enum(func(e interface{}) int32 { return int32(e.(*alm.AlarmDefinition).DefaultSeverity) }, l8events.Severity_name, "DefaultSeverity").
+		BeforeAction(validateDedupExpression).
 		Build()
 }
+
+// validateDedupExpression rejects definitions whose dedup_key_expression is malformed
+// or references unknown fields. A PATCH without the expression leaves it unchanged.
+func validateDedupExpression(def *alm.AlarmDefinition, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST && action != ifs.PUT && action != ifs.PATCH {
+		return nil
+	}
+	return dedup.Validate(def.DedupKeyExpression)
+}
@@ -15,7 +15,9 @@ func newAlarmServiceCallback(vnic ifs.IVNic) ifs.IServiceCallback {
 		Enum(func(e interface{}) int32 { return int32(e.(*alm.Alarm).State) }, l8events.AlarmState_name, "State").
 		Enum(func(e interface{}) int32 { return int32(e.(*alm.Alarm).Severity) }, l8events.Severity_name, "Severity").
 		BeforeAction(protectSystemFields).
+		BeforeAction(protectEngineFields).
 		BeforeAction(validateStateTransition).
+		BeforeAction(deduplicateAlarm).
 		BeforeAction(checkMaintenanceWindow).
 		After(runCorrelation).
 		After(runNotification).
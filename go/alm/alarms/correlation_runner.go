@@ -3,9 +3,10 @@ package alarms
 import (
 	"fmt"
 	"github.com/saichler/l8alarms/go/alm/correlation"
+	"github.com/saichler/l8alarms/go/alm/common"
 	"github.com/saichler/l8alarms/go/alm/correlationrules"
 	"github.com/saichler/l8alarms/go/types/alm"
-	"github.com/saichler/l8common/go/common"
+	l8common "github.com/saichler/l8common/go/common"
 	l8events "github.com/saichler/l8types/go/types/l8events"
 	"github.com/saichler/l8srlz/go/serialize/object"
 	"github.com/saichler/l8topology/go/types/l8topo"
@@ -16,8 +17,9 @@ var engine = correlation.NewEngine()
 
 // runCorrelation is called after an alarm is persisted (POST).
 // It queries active correlation rules and alarms, then runs the engine.
+// Restored alarms keep their archived correlation.
 func runCorrelation(alarm *alm.Alarm, action ifs.Action, vnic ifs.IVNic) error {
-	if action != ifs.POST {
+	if action != ifs.POST || common.IsQuiet(alarm) {
 		return nil
 	}
 
@@ -27,7 +29,7 @@ func runCorrelation(alarm *alm.Alarm, action ifs.Action, vnic ifs.IVNic) error {
 	}
 
 	// Fetch active correlation rules
-	rulesRaw, err := common.GetEntitiesByQuery(
+	rulesRaw, err := l8common.GetEntitiesByQuery(
 		correlationrules.ServiceName, correlationrules.ServiceArea,
 		fmt.Sprintf("select * from CorrelationRule where Status=%d",
 			alm.CorrelationRuleStatus_CORRELATION_RULE_STATUS_ACTIVE),
@@ -45,7 +47,7 @@ func runCorrelation(alarm *alm.Alarm, action ifs.Action, vnic ifs.IVNic) error {
 	}
 
 	// Fetch active alarms
-	activeAlarmsRaw, err := common.GetEntitiesByQuery(
+	activeAlarmsRaw, err := l8common.GetEntitiesByQuery(
 		ServiceName, ServiceArea,
 		fmt.Sprintf("select * from Alarm where State=%d",
 			l8events.AlarmState_ALARM_STATE_ACTIVE),
@@ -79,12 +81,12 @@ func runCorrelation(alarm *alm.Alarm, action ifs.Action, vnic ifs.IVNic) error {
 	}
 
 	// Persist the updated symptom alarm (this alarm)
-	if err := common.PutEntity(ServiceName, ServiceArea, alarm, vnic); err != nil {
+	if err := PutInternal(alarm, vnic); err != nil {
 		return fmt.Errorf("failed to update symptom alarm: %w", err)
 	}
 
 	// Persist the updated root cause alarm
-	if err := common.PutEntity(ServiceName, ServiceArea, rootCause, vnic); err != nil {
+	if err := PutInternal(rootCause, vnic); err != nil {
 		return fmt.Errorf("failed to update root cause alarm: %w", err)
 	}
 
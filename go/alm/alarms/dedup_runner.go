@@ -0,0 +1,96 @@
+package alarms
+
+import (
+	"errors"
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/alarmdefinitions"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/alm/dedup"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8common "github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"strings"
+	"time"
+)
+
+// ErrDuplicate rejects an alarm POST absorbed by an open alarm with the same
+// dedup key. The rejection is not a failure: the occurrence was recorded on
+// the open alarm. REST callers receive it as an error message starting with
+// "duplicate alarm:".
+var ErrDuplicate = errors.New("duplicate alarm")
+
+// IsDuplicate reports whether an alarm POST error is an ErrDuplicate, either
+// returned in-process or received as an error message.
+func IsDuplicate(err error) bool {
+	return err != nil && (errors.Is(err, ErrDuplicate) || strings.Contains(err.Error(), ErrDuplicate.Error()+":"))
+}
+
+// deduplicateAlarm runs before alarm persistence on POST.
+// If the alarm's definition has dedup enabled, the dedup key is computed (unless
+// already set) and an existing non-cleared alarm with the same key absorbs the
+// new one: its occurrence_count and last_occurrence are bumped, and the POST is
+// rejected with ErrDuplicate so that no duplicate row is inserted.
+// Only newly raised (ACTIVE) alarms are deduplicated; restored alarms are not.
+func deduplicateAlarm(alarm *alm.Alarm, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST || common.IsQuiet(alarm) {
+		return nil
+	}
+
+	def, err := alarmdefinitions.AlarmDefinition(alarm.DefinitionId, vnic)
+	if err != nil {
+		return fmt.Errorf("cannot resolve alarm definition %s: %w", alarm.DefinitionId, err)
+	}
+	if def == nil || !def.DedupEnabled {
+		return nil
+	}
+	if alarm.DedupKey == "" {
+		alarm.DedupKey = dedup.AlarmKey(def, alarm)
+	}
+
+	if alarm.State != l8events.AlarmState_ALARM_STATE_UNSPECIFIED &&
+		alarm.State != l8events.AlarmState_ALARM_STATE_ACTIVE {
+		return nil
+	}
+
+	existing, err := findDuplicate(alarm, vnic)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return nil
+	}
+
+	occurred := alarm.LastOccurrence
+	if occurred == 0 {
+		occurred = time.Now().Unix()
+	}
+	dedup.RecordOccurrence(existing, occurred, alarm.Severity, def.DedupEscalateSeverity)
+	if err := PutInternal(existing, vnic); err != nil {
+		return fmt.Errorf("failed to update alarm %s: %w", existing.AlarmId, err)
+	}
+	return fmt.Errorf("%w: absorbed by alarm %s (dedup key %s), occurrence count is now %d",
+		ErrDuplicate, existing.AlarmId, existing.DedupKey, existing.OccurrenceCount)
+}
+
+// findDuplicate returns another non-cleared alarm of the same definition with
+// the same dedup key, or nil if there is none.
+func findDuplicate(alarm *alm.Alarm, vnic ifs.IVNic) (*alm.Alarm, error) {
+	query := fmt.Sprintf("select * from Alarm where DefinitionId=%s and State!=%d",
+		alarm.DefinitionId, l8events.AlarmState_ALARM_STATE_CLEARED)
+	alarmsRaw, err := l8common.GetEntitiesByQuery(ServiceName, ServiceArea,
+		common.MatchQuery(query, "DedupKey", alarm.DedupKey), vnic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query alarms for dedup: %w", err)
+	}
+	for _, raw := range alarmsRaw {
+		a := raw.(*alm.Alarm)
+		if a.AlarmId == alarm.AlarmId || a.State == l8events.AlarmState_ALARM_STATE_CLEARED {
+			continue
+		}
+		if a.DedupKey == alarm.DedupKey {
+			return a, nil
+		}
+	}
+	return nil, nil
+}
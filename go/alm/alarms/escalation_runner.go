@@ -1,22 +1,33 @@
 package alarms
 
 import (
+	"github.com/saichler/l8alarms/go/alm/common"
 	"github.com/saichler/l8alarms/go/alm/escalation"
 	"github.com/saichler/l8alarms/go/types/alm"
 	"github.com/saichler/l8types/go/ifs"
 )
 
-var escScheduler = escalation.NewScheduler()
+var escScheduler = escalation.NewScheduler(GetAlarm)
 
 // runEscalation is called after an alarm is persisted.
 // On POST: schedules escalation timers for matching policies.
-// On PUT/PATCH: cancels escalation if alarm is acknowledged/cleared.
+// On PUT/PATCH: cancels escalation if alarm is acknowledged/cleared/suppressed,
+// and restarts it if the alarm is ACTIVE again.
+// Restored alarms are not escalated again.
 func runEscalation(alarm *alm.Alarm, action ifs.Action, vnic ifs.IVNic) error {
+	if common.IsQuiet(alarm) {
+		return nil
+	}
 	switch action {
 	case ifs.POST:
 		escScheduler.Schedule(alarm, vnic)
 	case ifs.PUT, ifs.PATCH:
-		escScheduler.HandleStateChange(alarm)
+		escScheduler.HandleStateChange(alarm, vnic)
 	}
 	return nil
 }
+
+// ResumeEscalations rebuilds the escalation timers persisted before a restart.
+func ResumeEscalations(vnic ifs.IVNic) {
+	escScheduler.Resume(vnic)
+}
@@ -0,0 +1,27 @@
+package alarms
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+// PutInternal updates an alarm on behalf of an internal engine (correlation,
+// maintenance, dedup, processing, auto-clear). Unlike an operator PUT it may
+// write the engine-managed fields guarded by protectEngineFields.
+func PutInternal(alarm *alm.Alarm, vnic ifs.IVNic) error {
+	handler, ok := Alarms(vnic)
+	if !ok {
+		return fmt.Errorf("Alarm service not available")
+	}
+	var resp ifs.IElements
+	common.Internal(alarm, func() {
+		resp = handler.Put(object.New(nil, alarm), vnic)
+	})
+	if resp != nil && resp.Error() != nil {
+		return resp.Error()
+	}
+	return nil
+}
@@ -1,30 +1,104 @@
 package alarms
 
 import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/alarmdefinitions"
+	"github.com/saichler/l8alarms/go/alm/common"
 	"github.com/saichler/l8alarms/go/alm/maintenancewindows"
 	"github.com/saichler/l8alarms/go/types/alm"
+	l8common "github.com/saichler/l8common/go/common"
 	l8events "github.com/saichler/l8types/go/types/l8events"
 	"github.com/saichler/l8types/go/ifs"
+	"time"
 )
 
 // checkMaintenanceWindow runs before alarm persistence on POST.
 // If the alarm's node is in an active maintenance window with suppress_alarms=true,
-// the alarm state is set to SUPPRESSED before it's saved.
+// the alarm state is set to SUPPRESSED before it's saved. Restored alarms keep
+// their archived state.
 func checkMaintenanceWindow(alarm *alm.Alarm, action ifs.Action, vnic ifs.IVNic) error {
-	if action != ifs.POST {
+	if action != ifs.POST || common.IsQuiet(alarm) {
 		return nil
 	}
 
 	result := maintenancewindows.Check(alarm, vnic)
-	if !result.InWindow {
+	if !result.InWindow || !result.SuppressAlarms {
 		return nil
 	}
+	if alarm.State == l8events.AlarmState_ALARM_STATE_UNSPECIFIED {
+		alarm.State = l8events.AlarmState_ALARM_STATE_ACTIVE
+	}
+	// Alarms created already cleared stay cleared
+	if !common.ValidTransition(alarm.State, l8events.AlarmState_ALARM_STATE_SUPPRESSED) {
+		return nil
+	}
+	suppressedBy := "maintenance:" + result.WindowId
+	return common.TransitionAlarm(alarm, l8events.AlarmState_ALARM_STATE_SUPPRESSED,
+		suppressedBy, common.SourceMaintenance, "node in maintenance window "+result.WindowId)
+}
+
+// ReleaseSuppressed re-evaluates the alarms a maintenance window suppressed,
+// once the window has ended. Alarms still covered by another window move to
+// that window. Alarms whose fault is still present go back to ACTIVE and are
+// notified; the others are cleared.
+func ReleaseSuppressed(w *alm.MaintenanceWindow, vnic ifs.IVNic) {
+	suppressedBy := "maintenance:" + w.WindowId
+	alarmsRaw, err := l8common.GetEntitiesByQuery(ServiceName, ServiceArea,
+		fmt.Sprintf("select * from Alarm where State=%d", l8events.AlarmState_ALARM_STATE_SUPPRESSED),
+		vnic,
+	)
+	if err != nil {
+		fmt.Printf("[maintenance] failed to query alarms suppressed by %s: %v\n", w.WindowId, err)
+		return
+	}
+
+	for _, raw := range alarmsRaw {
+		alarm := raw.(*alm.Alarm)
+		if alarm.SuppressedBy != suppressedBy {
+			continue
+		}
+		if err := releaseAlarm(alarm, suppressedBy, vnic); err != nil {
+			fmt.Printf("[maintenance] failed to release alarm %s: %v\n", alarm.AlarmId, err)
+		}
+	}
+}
 
-	if result.SuppressAlarms {
-		alarm.State = l8events.AlarmState_ALARM_STATE_SUPPRESSED
-		alarm.IsSuppressed = true
+func releaseAlarm(alarm *alm.Alarm, suppressedBy string, vnic ifs.IVNic) error {
+	result := maintenancewindows.Check(alarm, vnic)
+	if result.InWindow && result.SuppressAlarms {
 		alarm.SuppressedBy = "maintenance:" + result.WindowId
+		return PutInternal(alarm, vnic)
+	}
+
+	if !faultPresent(alarm, vnic) {
+		if err := common.TransitionAlarm(alarm, l8events.AlarmState_ALARM_STATE_CLEARED,
+			suppressedBy, common.SourceMaintenance, "fault no longer present when maintenance ended"); err != nil {
+			return err
+		}
+		return PutInternal(alarm, vnic)
 	}
 
+	if err := common.TransitionAlarm(alarm, l8events.AlarmState_ALARM_STATE_ACTIVE,
+		suppressedBy, common.SourceMaintenance, "maintenance window ended"); err != nil {
+		return err
+	}
+	if err := PutInternal(alarm, vnic); err != nil {
+		return err
+	}
+	notifEngine.NotifyReleased(alarm, vnic)
 	return nil
 }
+
+// faultPresent reports whether the alarm's fault is still present: it is, unless
+// its definition auto-clears and the alarm has not re-occurred within auto_clear_seconds.
+func faultPresent(alarm *alm.Alarm, vnic ifs.IVNic) bool {
+	def, err := alarmdefinitions.AlarmDefinition(alarm.DefinitionId, vnic)
+	if err != nil || def == nil || !def.AutoClearEnabled || def.AutoClearSeconds <= 0 {
+		return true
+	}
+	lastSeen := alarm.LastOccurrence
+	if lastSeen == 0 {
+		lastSeen = alarm.FirstOccurrence
+	}
+	return lastSeen == 0 || time.Now().Unix()-lastSeen < int64(def.AutoClearSeconds)
+}
@@ -1,6 +1,7 @@
 package alarms
 
 import (
+	"github.com/saichler/l8alarms/go/alm/common"
 	"github.com/saichler/l8alarms/go/alm/maintenancewindows"
 	"github.com/saichler/l8alarms/go/alm/notification"
 	"github.com/saichler/l8alarms/go/types/alm"
@@ -12,10 +13,14 @@ var notifEngine = notification.NewEngine()
 
 // runNotification is called after an alarm is persisted (POST, PUT, PATCH).
 // It evaluates notification policies and dispatches notifications.
+// Restored alarms were notified before they were archived.
 func runNotification(alarm *alm.Alarm, action ifs.Action, vnic ifs.IVNic) error {
 	if action != ifs.POST && action != ifs.PUT && action != ifs.PATCH {
 		return nil
 	}
+	if common.IsQuiet(alarm) {
+		return nil
+	}
 
 	// Skip suppressed alarms
 	if alarm.State == l8events.AlarmState_ALARM_STATE_SUPPRESSED {
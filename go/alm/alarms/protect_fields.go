@@ -2,16 +2,18 @@ package alarms
 
 import (
 	"fmt"
+	"github.com/saichler/l8alarms/go/alm/common"
 	"github.com/saichler/l8alarms/go/types/alm"
 	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
 )
 
-// protectSystemFields rejects PUT requests that attempt to modify identity/origin fields.
+// protectSystemFields rejects PUT and PATCH requests that attempt to modify identity/origin fields.
 // These fields define *what* the alarm is and *where* it came from — they are immutable after creation.
-// Fields updated by internal engines (correlation, maintenance, dedup) are NOT protected here,
-// because the engines use the same PUT path and cannot be distinguished from user requests.
+// A PATCH only carries the fields it changes, so an empty field there leaves the stored value as is.
+// Fields updated by internal engines are guarded separately by protectEngineFields.
 func protectSystemFields(incoming *alm.Alarm, action ifs.Action, vnic ifs.IVNic) error {
-	if action != ifs.PUT {
+	if action != ifs.PUT && action != ifs.PATCH {
 		return nil
 	}
 
@@ -24,43 +26,102 @@ func protectSystemFields(incoming *alm.Alarm, action ifs.Action, vnic ifs.IVNic)
 	}
 
 	// Identity / origin fields — immutable after creation
-	if incoming.DefinitionId != existing.DefinitionId {
+	if modified(action, incoming.DefinitionId, existing.DefinitionId) {
 		return fieldProtectionError("definitionId")
 	}
-	if incoming.Name != existing.Name {
+	if modified(action, incoming.Name, existing.Name) {
 		return fieldProtectionError("name")
 	}
-	if incoming.Description != existing.Description {
+	if modified(action, incoming.Description, existing.Description) {
 		return fieldProtectionError("description")
 	}
-	if incoming.OriginalSeverity != existing.OriginalSeverity {
+	if (action != ifs.PATCH || incoming.OriginalSeverity != l8events.Severity_SEVERITY_UNSPECIFIED) &&
+		incoming.OriginalSeverity != existing.OriginalSeverity {
 		return fieldProtectionError("originalSeverity")
 	}
-	if incoming.NodeId != existing.NodeId {
+	if modified(action, incoming.NodeId, existing.NodeId) {
 		return fieldProtectionError("nodeId")
 	}
-	if incoming.NodeName != existing.NodeName {
+	if modified(action, incoming.NodeName, existing.NodeName) {
 		return fieldProtectionError("nodeName")
 	}
-	if incoming.LinkId != existing.LinkId {
+	if modified(action, incoming.LinkId, existing.LinkId) {
 		return fieldProtectionError("linkId")
 	}
-	if incoming.Location != existing.Location {
+	if modified(action, incoming.Location, existing.Location) {
 		return fieldProtectionError("location")
 	}
-	if incoming.SourceIdentifier != existing.SourceIdentifier {
+	if modified(action, incoming.SourceIdentifier, existing.SourceIdentifier) {
 		return fieldProtectionError("sourceIdentifier")
 	}
-	if incoming.EventId != existing.EventId {
+	if modified(action, incoming.EventId, existing.EventId) {
 		return fieldProtectionError("eventId")
 	}
-	if incoming.DedupKey != existing.DedupKey {
+	if modified(action, incoming.DedupKey, existing.DedupKey) {
 		return fieldProtectionError("dedupKey")
 	}
 
 	return nil
 }
 
+// protectEngineFields locks the fields written by the correlation and maintenance
+// engines against operator PUT and PATCH requests. Engines write through PutInternal,
+// which marks the request as internal. An operator request that leaves an engine field
+// empty or unchanged keeps the stored value, so a full-object PUT does not wipe it;
+// one that sets it to another value is rejected. Alarms created by POST (e.g. imports)
+// may carry the fields.
+func protectEngineFields(incoming *alm.Alarm, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.PUT && action != ifs.PATCH {
+		return nil
+	}
+	if common.IsInternal(incoming) {
+		return nil
+	}
+
+	existing, err := GetAlarm(incoming.AlarmId, vnic)
+	if err != nil {
+		return fmt.Errorf("cannot verify alarm fields: %w", err)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	if modified(ifs.PATCH, incoming.RootCauseAlarmId, existing.RootCauseAlarmId) {
+		return engineFieldError("rootCauseAlarmId")
+	}
+	if incoming.IsRootCause && !existing.IsRootCause {
+		return engineFieldError("isRootCause")
+	}
+	if incoming.SymptomCount != 0 && incoming.SymptomCount != existing.SymptomCount {
+		return engineFieldError("symptomCount")
+	}
+	if incoming.IsSuppressed && !existing.IsSuppressed {
+		return engineFieldError("isSuppressed")
+	}
+	if modified(ifs.PATCH, incoming.SuppressedBy, existing.SuppressedBy) {
+		return engineFieldError("suppressedBy")
+	}
+
+	incoming.RootCauseAlarmId = existing.RootCauseAlarmId
+	incoming.IsRootCause = existing.IsRootCause
+	incoming.SymptomCount = existing.SymptomCount
+	incoming.IsSuppressed = existing.IsSuppressed
+	incoming.SuppressedBy = existing.SuppressedBy
+	return nil
+}
+
+// modified reports whether a request changes a field.
+func modified(action ifs.Action, incoming, existing string) bool {
+	if action == ifs.PATCH && incoming == "" {
+		return false
+	}
+	return incoming != existing
+}
+
 func fieldProtectionError(field string) error {
 	return fmt.Errorf("%s is a system-managed field and cannot be modified", field)
 }
+
+func engineFieldError(field string) error {
+	return fmt.Errorf("%s is managed by the alarm engines and cannot be modified", field)
+}
@@ -9,14 +9,14 @@ import (
 )
 
 // validateStateTransition uses the l8events state machine to validate that
-// alarm state changes follow allowed transitions. Only applies on PUT.
+// alarm state changes follow allowed transitions, on PUT and PATCH alike.
+// An operator state change is applied through common.TransitionAlarm, so it is
+// recorded in state_history with the acknowledged/cleared stamps; operators
+// cannot write state_history or the stamps directly. Engines transition the
+// alarm themselves before writing it through PutInternal. A change written
+// through Update is recorded as made by its changedBy, with its reason.
 func validateStateTransition(incoming *alm.Alarm, action ifs.Action, vnic ifs.IVNic) error {
-	if action != ifs.PUT {
-		return nil
-	}
-
-	// UNSPECIFIED state means the caller isn't changing state
-	if incoming.State == l8events.AlarmState_ALARM_STATE_UNSPECIFIED {
+	if action != ifs.PUT && action != ifs.PATCH {
 		return nil
 	}
 
@@ -28,15 +28,51 @@ func validateStateTransition(incoming *alm.Alarm, action ifs.Action, vnic ifs.IV
 		return nil
 	}
 
-	// If state hasn't changed, nothing to validate
-	if incoming.State == existing.State {
+	// UNSPECIFIED state means the caller isn't changing state
+	newState := incoming.State
+	changing := newState != l8events.AlarmState_ALARM_STATE_UNSPECIFIED && newState != existing.State
+	if changing && !common.ValidTransition(existing.State, newState) {
+		return fmt.Errorf("invalid state transition from %s to %s",
+			existing.State.String(), newState.String())
+	}
+	if common.IsInternal(incoming) {
 		return nil
 	}
 
-	if !common.ValidTransition(existing.State, incoming.State) {
-		return fmt.Errorf("invalid state transition from %s to %s",
-			existing.State.String(), incoming.State.String())
+	changedBy, reason, ok := common.OperatorChange(incoming)
+	if !ok {
+		changedBy = operator(incoming, newState)
+	}
+	incoming.State = existing.State
+	incoming.StateHistory = existing.StateHistory
+	incoming.AcknowledgedBy = existing.AcknowledgedBy
+	incoming.AcknowledgedAt = existing.AcknowledgedAt
+	incoming.ClearedBy = existing.ClearedBy
+	incoming.ClearedAt = existing.ClearedAt
+	if !changing {
+		return nil
+	}
+	if err := common.TransitionAlarm(incoming, newState, changedBy, common.SourceOperator, reason); err != nil {
+		return err
+	}
+	// Unacknowledging withdraws the acknowledgement
+	if existing.State == l8events.AlarmState_ALARM_STATE_ACKNOWLEDGED && newState == l8events.AlarmState_ALARM_STATE_ACTIVE {
+		incoming.AcknowledgedBy = ""
+		incoming.AcknowledgedAt = 0
 	}
-
 	return nil
 }
+
+// operator returns who makes an operator state change: the acknowledged_by or
+// cleared_by sent with it, or "operator" if it names no one. The service
+// callbacks see no authenticated principal, so the name is as claimed by the
+// client and is recorded unverified.
+func operator(incoming *alm.Alarm, newState l8events.AlarmState) string {
+	switch {
+	case newState == l8events.AlarmState_ALARM_STATE_ACKNOWLEDGED && incoming.AcknowledgedBy != "":
+		return incoming.AcknowledgedBy
+	case newState == l8events.AlarmState_ALARM_STATE_CLEARED && incoming.ClearedBy != "":
+		return incoming.ClearedBy
+	}
+	return common.SourceOperator
+}
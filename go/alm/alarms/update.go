@@ -0,0 +1,59 @@
+package alarms
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"google.golang.org/protobuf/proto"
+	"hash/fnv"
+	"sync"
+)
+
+// updateLocks serialize the Update calls of an alarm; an alarm ID always
+// hashes to the same lock.
+var updateLocks [64]sync.Mutex
+
+func updateLock(alarmId string) *sync.Mutex {
+	h := fnv.New32a()
+	h.Write([]byte(alarmId))
+	return &updateLocks[h.Sum32()%uint32(len(updateLocks))]
+}
+
+// Update changes an alarm on behalf of an operator, e.g. for an alarm action.
+// Under a lock of the alarm, fn is applied to a fresh copy of the stored
+// alarm and the copy is written, so concurrent updates of an alarm apply one
+// after the other rather than overwriting each other. The write is an
+// operator PUT marked by common.Operator: the engine fields stay protected,
+// a state change must be valid and is recorded as made by changedBy for
+// reason. Returns the alarm as written.
+func Update(alarmId, changedBy, reason string, fn func(*alm.Alarm) error, vnic ifs.IVNic) (*alm.Alarm, error) {
+	handler, ok := Alarms(vnic)
+	if !ok {
+		return nil, fmt.Errorf("Alarm service not available")
+	}
+	lock := updateLock(alarmId)
+	lock.Lock()
+	defer lock.Unlock()
+
+	stored, err := GetAlarm(alarmId, vnic)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		return nil, fmt.Errorf("alarm not found")
+	}
+	alarm := proto.Clone(stored).(*alm.Alarm)
+	if err := fn(alarm); err != nil {
+		return nil, err
+	}
+	var resp ifs.IElements
+	common.Operator(alarm, changedBy, reason, func() {
+		resp = handler.Put(object.New(nil, alarm), vnic)
+	})
+	if resp != nil && resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	return alarm, nil
+}
@@ -0,0 +1,86 @@
+package archiveexport
+
+import (
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+)
+
+const (
+	ServiceName = "ArcExport"
+	ServiceArea = byte(10)
+)
+
+// ArchiveExportService exports archived alarms or events to a file. A POSTed
+// ArchiveExportRequest is streamed to a CSV, JSONL or Parquet file under
+// common.EXPORT_DIRECTORY and an ArchiveExportResponse with its path is returned.
+type ArchiveExportService struct {
+	serviceName string
+	serviceArea byte
+}
+
+func Activate(vnic ifs.IVNic) {
+	svc := &ArchiveExportService{}
+	sla := ifs.NewServiceLevelAgreement(svc, ServiceName, ServiceArea, true, nil)
+	sla.SetServiceItem(&alm.ArchiveExportRequest{})
+	sla.SetServiceItemList(&alm.ArchiveExportResponse{})
+
+	ws := web.New(ServiceName, ServiceArea, 0)
+	ws.AddEndpoint(&alm.ArchiveExportRequest{}, ifs.POST, &alm.ArchiveExportResponse{})
+	sla.SetWebService(ws)
+
+	vnic.Resources().Services().Activate(sla, vnic)
+}
+
+func (s *ArchiveExportService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	s.serviceName = sla.ServiceName()
+	s.serviceArea = sla.ServiceArea()
+	return nil
+}
+
+func (s *ArchiveExportService) DeActivate() error { return nil }
+
+// Post writes the records selected by the request to a new export file.
+func (s *ArchiveExportService) Post(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	req, ok := elements.Element().(*alm.ArchiveExportRequest)
+	if !ok || req == nil {
+		return object.NewError("invalid request: expected ArchiveExportRequest")
+	}
+	resp, err := Export(req, common.EXPORT_DIRECTORY, vnic)
+	if err != nil {
+		return object.NewError("export failed: " + err.Error())
+	}
+	return object.New(nil, resp)
+}
+
+func (s *ArchiveExportService) Get(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("archive export service only supports POST")
+}
+
+func (s *ArchiveExportService) Put(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("archive export service only supports POST")
+}
+
+func (s *ArchiveExportService) Patch(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("archive export service only supports POST")
+}
+
+func (s *ArchiveExportService) Delete(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("archive export service only supports POST")
+}
+
+func (s *ArchiveExportService) Failed(elements ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (s *ArchiveExportService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (s *ArchiveExportService) WebService() ifs.IWebService {
+	ws := web.New(s.serviceName, s.serviceArea, 0)
+	ws.AddEndpoint(&alm.ArchiveExportRequest{}, ifs.POST, &alm.ArchiveExportResponse{})
+	return ws
+}
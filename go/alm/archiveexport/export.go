@@ -0,0 +1,149 @@
+package archiveexport
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/archivedalarms"
+	"github.com/saichler/l8alarms/go/alm/archivedevents"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// pageSize is how many archived records are fetched per query, bounding the
+// records held in memory during an export.
+const pageSize = 500
+
+// Export streams the archived alarms or events matching the request to a new
+// file under dir, page by page, and returns its path and record count.
+// A partially written file is removed on failure.
+func Export(req *alm.ArchiveExportRequest, dir string, vnic ifs.IVNic) (*alm.ArchiveExportResponse, error) {
+	var kind string
+	var columns []column
+	switch req.RecordType {
+	case alm.ArchiveRecordType_ARCHIVE_RECORD_TYPE_ALARM:
+		kind, columns = "archived-alarms", alarmColumns(req.AttributeKeys)
+	case alm.ArchiveRecordType_ARCHIVE_RECORD_TYPE_EVENT:
+		kind, columns = "archived-events", eventColumns(req.AttributeKeys)
+	default:
+		return nil, fmt.Errorf("unsupported record type %s", req.RecordType.String())
+	}
+	ext := fileExtension(req.Format)
+	if ext == "" {
+		return nil, fmt.Errorf("unsupported export format %s", req.Format.String())
+	}
+	if req.FromTime != 0 && req.ToTime != 0 && req.ToTime < req.FromTime {
+		return nil, fmt.Errorf("toTime must not be before fromTime")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.%s", kind, time.Now().UTC().Format("20060102T150405.000000000Z"), ext))
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := writeExport(req, f, columns, vnic)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return nil, err
+	}
+	return &alm.ArchiveExportResponse{FilePath: path, RecordCount: count}, nil
+}
+
+func writeExport(req *alm.ArchiveExportRequest, f *os.File, columns []column, vnic ifs.IVNic) (int32, error) {
+	bw := bufio.NewWriter(f)
+	rw, err := newRowWriter(req.Format, bw, columns)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int32
+	if req.RecordType == alm.ArchiveRecordType_ARCHIVE_RECORD_TYPE_ALARM {
+		count, err = exportAlarms(req, rw, vnic)
+	} else {
+		count, err = exportEvents(req, rw, vnic)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := rw.close(); err != nil {
+		return 0, err
+	}
+	return count, bw.Flush()
+}
+
+func exportAlarms(req *alm.ArchiveExportRequest, rw rowWriter, vnic ifs.IVNic) (int32, error) {
+	var count int32
+	for page := 0; ; page++ {
+		raws, err := common.GetEntitiesByQuery(archivedalarms.ServiceName, archivedalarms.ServiceArea,
+			pageQuery("ArchivedAlarm", "AlarmId", "FirstOccurrence", req, page), vnic)
+		if err != nil {
+			return 0, fmt.Errorf("failed to query archived alarms: %w", err)
+		}
+		for _, raw := range raws {
+			a := raw.(*alm.ArchivedAlarm)
+			if err := rw.writeRow(alarmRow(a, req.AttributeKeys)); err != nil {
+				return 0, err
+			}
+			count++
+		}
+		if len(raws) < pageSize {
+			return count, nil
+		}
+	}
+}
+
+func exportEvents(req *alm.ArchiveExportRequest, rw rowWriter, vnic ifs.IVNic) (int32, error) {
+	var count int32
+	for page := 0; ; page++ {
+		raws, err := common.GetEntitiesByQuery(archivedevents.ServiceName, archivedevents.ServiceArea,
+			pageQuery("ArchivedEvent", "EventId", "OccurredAt", req, page), vnic)
+		if err != nil {
+			return 0, fmt.Errorf("failed to query archived events: %w", err)
+		}
+		for _, raw := range raws {
+			e := raw.(*alm.ArchivedEvent)
+			if err := rw.writeRow(eventRow(e, req.AttributeKeys)); err != nil {
+				return 0, err
+			}
+			count++
+		}
+		if len(raws) < pageSize {
+			return count, nil
+		}
+	}
+}
+
+// pageQuery selects one page of the table, filtered by the request's node,
+// definition and time range on timeField. Pages are sorted by the primary
+// key, so paging is stable.
+func pageQuery(table, primaryKey, timeField string, req *alm.ArchiveExportRequest, page int) string {
+	var conditions []string
+	if req.NodeId != "" {
+		conditions = append(conditions, "NodeId="+req.NodeId)
+	}
+	if req.DefinitionId != "" {
+		conditions = append(conditions, "DefinitionId="+req.DefinitionId)
+	}
+	if req.FromTime != 0 {
+		conditions = append(conditions, fmt.Sprintf("%s>=%d", timeField, req.FromTime))
+	}
+	if req.ToTime != 0 {
+		conditions = append(conditions, fmt.Sprintf("%s<=%d", timeField, req.ToTime))
+	}
+	query := "select * from " + table
+	if len(conditions) > 0 {
+		query += " where " + strings.Join(conditions, " and ")
+	}
+	return fmt.Sprintf("%s limit %d page %d sort-by %s", query, pageSize, page, primaryKey)
+}
@@ -0,0 +1,174 @@
+package archiveexport
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// column is one flat output column. Integer columns are written as integers
+// in JSONL and Parquet; all other columns are strings.
+type column struct {
+	name    string
+	integer bool
+}
+
+func text(name string) column    { return column{name: name} }
+func integer(name string) column { return column{name: name, integer: true} }
+
+// alarmColumns are the columns of an archived alarm export, followed by one
+// attr_<key> column per requested attribute key.
+func alarmColumns(attributeKeys []string) []column {
+	columns := []column{
+		text("alarm_id"), text("definition_id"), text("name"), text("description"),
+		text("state"), text("severity"), text("original_severity"),
+		text("node_id"), text("node_name"), text("link_id"), text("location"), text("source_identifier"),
+		text("root_cause_alarm_id"), text("correlation_rule_id"), text("is_root_cause"), integer("symptom_count"),
+		integer("first_occurrence"), integer("last_occurrence"),
+		integer("acknowledged_at"), text("acknowledged_by"), integer("cleared_at"), text("cleared_by"),
+		integer("assigned_at"), text("assigned_to"),
+		integer("occurrence_count"), text("dedup_key"), text("is_suppressed"), text("suppressed_by"),
+		text("event_id"), integer("archived_at"), text("archived_by"),
+		integer("note_count"), text("notes"), integer("state_change_count"), text("state_history"),
+		text("attributes"),
+	}
+	return append(columns, attributeColumns(attributeKeys)...)
+}
+
+func alarmRow(a *alm.ArchivedAlarm, attributeKeys []string) []string {
+	row := []string{
+		a.AlarmId, a.DefinitionId, a.Name, a.Description,
+		stateName(a.State), severityName(a.Severity), severityName(a.OriginalSeverity),
+		a.NodeId, a.NodeName, a.LinkId, a.Location, a.SourceIdentifier,
+		a.RootCauseAlarmId, a.CorrelationRuleId, strconv.FormatBool(a.IsRootCause), strconv.Itoa(int(a.SymptomCount)),
+		timeValue(a.FirstOccurrence), timeValue(a.LastOccurrence),
+		timeValue(a.AcknowledgedAt), a.AcknowledgedBy, timeValue(a.ClearedAt), a.ClearedBy,
+		timeValue(a.AssignedAt), a.AssignedTo,
+		strconv.Itoa(int(a.OccurrenceCount)), a.DedupKey, strconv.FormatBool(a.IsSuppressed), a.SuppressedBy,
+		a.EventId, timeValue(a.ArchivedAt), a.ArchivedBy,
+		strconv.Itoa(len(a.Notes)), flattenNotes(a.Notes),
+		strconv.Itoa(len(a.StateHistory)), flattenStateHistory(a.StateHistory),
+		flattenAttributes(a.Attributes),
+	}
+	for _, key := range attributeKeys {
+		row = append(row, a.Attributes[key])
+	}
+	return row
+}
+
+// eventColumns are the columns of an archived event export, followed by one
+// attr_<key> column per requested attribute key.
+func eventColumns(attributeKeys []string) []column {
+	columns := []column{
+		text("event_id"), text("event_type"), text("processing_state"),
+		text("node_id"), text("node_name"), text("source_identifier"),
+		text("severity"), text("message"), text("raw_data"), text("category"), text("subcategory"),
+		text("alarm_id"), text("definition_id"),
+		integer("occurred_at"), integer("received_at"), integer("processed_at"),
+		integer("archived_at"), text("archived_by"),
+		text("attributes"),
+	}
+	return append(columns, attributeColumns(attributeKeys)...)
+}
+
+func eventRow(e *alm.ArchivedEvent, attributeKeys []string) []string {
+	attributes := make(map[string]string, len(e.Attributes))
+	for _, attr := range e.Attributes {
+		attributes[attr.Key] = attr.Value
+	}
+	row := []string{
+		e.EventId, strings.TrimPrefix(e.EventType.String(), "ALM_EVENT_TYPE_"),
+		strings.TrimPrefix(e.ProcessingState.String(), "EVENT_STATE_"),
+		e.NodeId, e.NodeName, e.SourceIdentifier,
+		severityName(e.Severity), e.Message, e.RawData, e.Category, e.Subcategory,
+		e.AlarmId, e.DefinitionId,
+		timeValue(e.OccurredAt), timeValue(e.ReceivedAt), timeValue(e.ProcessedAt),
+		timeValue(e.ArchivedAt), e.ArchivedBy,
+		flattenAttributes(attributes),
+	}
+	for _, key := range attributeKeys {
+		row = append(row, attributes[key])
+	}
+	return row
+}
+
+// attributeColumns names the attr_<key> columns, replacing characters that
+// are not letters, digits or '_' with '_'.
+func attributeColumns(attributeKeys []string) []column {
+	columns := make([]column, 0, len(attributeKeys))
+	for _, key := range attributeKeys {
+		name := strings.Map(func(r rune) rune {
+			if r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+				return r
+			}
+			return '_'
+		}, key)
+		columns = append(columns, text("attr_"+name))
+	}
+	return columns
+}
+
+// flattenNotes renders notes oldest first as "<time> <author>: <text>", separated by " | ".
+func flattenNotes(notes []*l8events.AlarmNote) string {
+	parts := make([]string, 0, len(notes))
+	for _, n := range notes {
+		parts = append(parts, fmt.Sprintf("%s %s: %s", formatTime(n.CreatedAt), n.Author, n.Text))
+	}
+	return strings.Join(parts, " | ")
+}
+
+// flattenStateHistory renders state changes as "<from>-><to> <time> by <who> (<reason>)",
+// separated by " | ".
+func flattenStateHistory(history []*l8events.AlarmStateChange) string {
+	parts := make([]string, 0, len(history))
+	for _, c := range history {
+		part := fmt.Sprintf("%s->%s %s by %s", stateName(c.FromState), stateName(c.ToState), formatTime(c.ChangedAt), c.ChangedBy)
+		if c.Reason != "" {
+			part += " (" + c.Reason + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " | ")
+}
+
+// flattenAttributes renders attributes as "key=value" pairs sorted by key, separated by ";".
+func flattenAttributes(attributes map[string]string) string {
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, k+"="+attributes[k])
+	}
+	return strings.Join(parts, ";")
+}
+
+func stateName(s l8events.AlarmState) string {
+	return strings.TrimPrefix(s.String(), "ALARM_STATE_")
+}
+
+func severityName(s l8events.Severity) string {
+	return strings.TrimPrefix(s.String(), "SEVERITY_")
+}
+
+// timeValue renders a unix time column; 0 (unset) is left empty.
+func timeValue(t int64) string {
+	if t == 0 {
+		return ""
+	}
+	return strconv.FormatInt(t, 10)
+}
+
+// formatTime renders a unix time as RFC3339, or "-" when unset.
+func formatTime(t int64) string {
+	if t == 0 {
+		return "-"
+	}
+	return time.Unix(t, 0).UTC().Format(time.RFC3339)
+}
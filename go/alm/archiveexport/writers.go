@@ -0,0 +1,147 @@
+package archiveexport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/xitongsys/parquet-go/writer"
+	"io"
+)
+
+// parquetRowGroupSize bounds how many bytes of rows are buffered before a
+// Parquet row group is flushed to the file.
+const parquetRowGroupSize = 16 * 1024 * 1024
+
+// rowWriter streams flat rows to an export file.
+type rowWriter interface {
+	writeRow(values []string) error
+	close() error
+}
+
+// fileExtension returns the file extension of the format, or "" if unsupported.
+func fileExtension(format alm.ArchiveExportFormat) string {
+	switch format {
+	case alm.ArchiveExportFormat_ARCHIVE_EXPORT_FORMAT_CSV:
+		return "csv"
+	case alm.ArchiveExportFormat_ARCHIVE_EXPORT_FORMAT_JSONL:
+		return "jsonl"
+	case alm.ArchiveExportFormat_ARCHIVE_EXPORT_FORMAT_PARQUET:
+		return "parquet"
+	}
+	return ""
+}
+
+func newRowWriter(format alm.ArchiveExportFormat, w io.Writer, columns []column) (rowWriter, error) {
+	switch format {
+	case alm.ArchiveExportFormat_ARCHIVE_EXPORT_FORMAT_CSV:
+		return newCSVRowWriter(w, columns)
+	case alm.ArchiveExportFormat_ARCHIVE_EXPORT_FORMAT_JSONL:
+		return &jsonlRowWriter{w: w, columns: columns}, nil
+	case alm.ArchiveExportFormat_ARCHIVE_EXPORT_FORMAT_PARQUET:
+		return newParquetRowWriter(w, columns)
+	}
+	return nil, fmt.Errorf("unsupported export format %s", format.String())
+}
+
+// csvRowWriter writes a header line followed by one line per row.
+type csvRowWriter struct {
+	w *csv.Writer
+}
+
+func newCSVRowWriter(w io.Writer, columns []column) (*csvRowWriter, error) {
+	cw := csv.NewWriter(w)
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.name
+	}
+	if err := cw.Write(header); err != nil {
+		return nil, err
+	}
+	return &csvRowWriter{w: cw}, nil
+}
+
+func (c *csvRowWriter) writeRow(values []string) error {
+	return c.w.Write(values)
+}
+
+func (c *csvRowWriter) close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonlRowWriter writes one JSON object per row, keeping column order and
+// omitting empty values.
+type jsonlRowWriter struct {
+	w       io.Writer
+	columns []column
+}
+
+func (j *jsonlRowWriter) writeRow(values []string) error {
+	line := make([]byte, 0, 512)
+	line = append(line, '{')
+	first := true
+	for i, c := range j.columns {
+		if values[i] == "" {
+			continue
+		}
+		if !first {
+			line = append(line, ',')
+		}
+		first = false
+		name, _ := json.Marshal(c.name)
+		line = append(line, name...)
+		line = append(line, ':')
+		if c.integer {
+			line = append(line, values[i]...)
+			continue
+		}
+		value, err := json.Marshal(values[i])
+		if err != nil {
+			return err
+		}
+		line = append(line, value...)
+	}
+	line = append(line, '}', '\n')
+	_, err := j.w.Write(line)
+	return err
+}
+
+func (j *jsonlRowWriter) close() error { return nil }
+
+// parquetRowWriter writes rows to a Parquet file with one optional column per
+// export column, flushing a row group every parquetRowGroupSize bytes.
+type parquetRowWriter struct {
+	pw *writer.CSVWriter
+}
+
+func newParquetRowWriter(w io.Writer, columns []column) (*parquetRowWriter, error) {
+	md := make([]string, len(columns))
+	for i, c := range columns {
+		if c.integer {
+			md[i] = fmt.Sprintf("name=%s, type=INT64, repetitiontype=OPTIONAL", c.name)
+		} else {
+			md[i] = fmt.Sprintf("name=%s, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL", c.name)
+		}
+	}
+	pw, err := writer.NewCSVWriterFromWriter(md, w, 4)
+	if err != nil {
+		return nil, err
+	}
+	pw.RowGroupSize = parquetRowGroupSize
+	return &parquetRowWriter{pw: pw}, nil
+}
+
+func (p *parquetRowWriter) writeRow(values []string) error {
+	rec := make([]*string, len(values))
+	for i := range values {
+		if values[i] != "" {
+			rec[i] = &values[i]
+		}
+	}
+	return p.pw.WriteString(rec)
+}
+
+func (p *parquetRowWriter) close() error {
+	return p.pw.WriteStop()
+}
@@ -0,0 +1,31 @@
+package archivepolicies
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+const (
+	ServiceName = "ArcPolicy"
+	ServiceArea = byte(10)
+)
+
+func Activate(creds, dbname string, vnic ifs.IVNic) {
+	common.ActivateService(common.ServiceConfig{
+		ServiceName: ServiceName, ServiceArea: ServiceArea,
+		PrimaryKey: "PolicyId", Callback: newArchiveRetentionPolicyServiceCallback(vnic),
+	}, &alm.ArchiveRetentionPolicy{}, &alm.ArchiveRetentionPolicyList{}, creds, dbname, vnic)
+}
+
+func ArchiveRetentionPolicies(vnic ifs.IVNic) (ifs.IServiceHandler, bool) {
+	return common.ServiceHandler(ServiceName, ServiceArea, vnic)
+}
+
+func ArchiveRetentionPolicy(id string, vnic ifs.IVNic) (*alm.ArchiveRetentionPolicy, error) {
+	result, err := common.GetEntity(ServiceName, ServiceArea, &alm.ArchiveRetentionPolicy{PolicyId: id}, vnic)
+	if err != nil || result == nil {
+		return nil, err
+	}
+	return result.(*alm.ArchiveRetentionPolicy), nil
+}
@@ -0,0 +1,43 @@
+package archivepolicies
+
+import (
+	"errors"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8common "github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+func newArchiveRetentionPolicyServiceCallback(vnic ifs.IVNic) ifs.IServiceCallback {
+	return l8common.NewValidation(&alm.ArchiveRetentionPolicy{}, vnic).
+		Require(func(e interface{}) string { return e.(*alm.ArchiveRetentionPolicy).PolicyId }, "PolicyId").
+		Require(func(e interface{}) string { return e.(*alm.ArchiveRetentionPolicy).Name }, "Name").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.ArchiveRetentionPolicy).Status) }, alm.AlmPolicyStatus_name, "Status").
+		BeforeAction(validateRetention).
+		Build()
+}
+
+// validateRetention rejects policies that would purge nothing, or everything
+// regardless of age, and exports that would be written outside EXPORT_DIRECTORY.
+func validateRetention(policy *alm.ArchiveRetentionPolicy, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST && action != ifs.PUT && action != ifs.PATCH {
+		return nil
+	}
+	// The policy ID names the export file, the export directory its subdirectory
+	if err := common.SafeName("policyId", policy.PolicyId); err != nil {
+		return err
+	}
+	if err := common.SafeName("exportDirectory", policy.ExportDirectory); err != nil {
+		return err
+	}
+	if action == ifs.PATCH {
+		return nil
+	}
+	if !policy.PurgeAlarms && !policy.PurgeEvents {
+		return errors.New("at least one of purgeAlarms or purgeEvents is required")
+	}
+	if policy.MaxAgeDays <= 0 {
+		return errors.New("maxAgeDays must be greater than 0")
+	}
+	return nil
+}
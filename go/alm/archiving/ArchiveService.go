@@ -0,0 +1,152 @@
+package archiving
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/alarms"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+)
+
+const (
+	ServiceName = "AlmArchive"
+	ServiceArea = byte(10)
+)
+
+// ArchivedByDefault is recorded as archived_by when a request does not set one.
+const ArchivedByDefault = "system:archive"
+
+// ArchiveService exposes ArchiveAlarm as an action. A POSTed ArchiveRequest
+// archives a single alarm by ID, or every alarm matching its query, and
+// returns an ArchiveResponse. With rollback set, it undoes an interrupted
+// archive of the alarm instead; with restore set, it restores the archived
+// alarm tree.
+type ArchiveService struct {
+	serviceName string
+	serviceArea byte
+}
+
+func Activate(vnic ifs.IVNic) {
+	svc := &ArchiveService{}
+	sla := ifs.NewServiceLevelAgreement(svc, ServiceName, ServiceArea, true, nil)
+	sla.SetServiceItem(&alm.ArchiveRequest{})
+	sla.SetServiceItemList(&alm.ArchiveResponse{})
+
+	ws := web.New(ServiceName, ServiceArea, 0)
+	ws.AddEndpoint(&alm.ArchiveRequest{}, ifs.POST, &alm.ArchiveResponse{})
+	sla.SetWebService(ws)
+
+	vnic.Resources().Services().Activate(sla, vnic)
+}
+
+func (s *ArchiveService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	s.serviceName = sla.ServiceName()
+	s.serviceArea = sla.ServiceArea()
+	return nil
+}
+
+func (s *ArchiveService) DeActivate() error { return nil }
+
+// Post archives the alarm_id of the request, or every alarm matching its query,
+// or restores or rolls back the alarm_id when requested.
+func (s *ArchiveService) Post(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	req, ok := elements.Element().(*alm.ArchiveRequest)
+	if !ok || req == nil {
+		return object.NewError("invalid request: expected ArchiveRequest")
+	}
+	if (req.AlarmId == "") == (req.Query == "") {
+		return object.NewError("invalid request: exactly one of alarmId or query is required")
+	}
+
+	archivedBy := req.ArchivedBy
+	if archivedBy == "" {
+		archivedBy = ArchivedByDefault
+	}
+
+	if req.Rollback {
+		if req.AlarmId == "" {
+			return object.NewError("invalid request: rollback requires alarmId")
+		}
+		if err := RollbackArchive(req.AlarmId, vnic); err != nil {
+			return object.NewError(err.Error())
+		}
+		return object.New(nil, &alm.ArchiveResponse{})
+	}
+
+	if req.Restore {
+		if req.AlarmId == "" {
+			return object.NewError("invalid request: restore requires alarmId")
+		}
+		restored, err := RestoreAlarm(req.AlarmId, archivedBy, vnic)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		return object.New(nil, &alm.ArchiveResponse{RestoredAlarmIds: restored})
+	}
+
+	if req.AlarmId != "" {
+		if err := ArchiveAlarm(req.AlarmId, archivedBy, vnic); err != nil {
+			return object.NewError(err.Error())
+		}
+		return object.New(nil, &alm.ArchiveResponse{ArchivedAlarmIds: []string{req.AlarmId}})
+	}
+
+	alarmsRaw, err := common.GetEntitiesByQuery(alarms.ServiceName, alarms.ServiceArea, req.Query, vnic)
+	if err != nil {
+		return object.NewError("failed to query alarms: " + err.Error())
+	}
+	ids := make([]string, 0, len(alarmsRaw))
+	for _, raw := range alarmsRaw {
+		ids = append(ids, raw.(*alm.Alarm).AlarmId)
+	}
+	return object.New(nil, ArchiveAlarms(ids, archivedBy, vnic))
+}
+
+// ArchiveAlarms archives each alarm in turn and reports which succeeded.
+// Alarms already archived as a symptom of an earlier one are skipped.
+func ArchiveAlarms(alarmIds []string, archivedBy string, vnic ifs.IVNic) *alm.ArchiveResponse {
+	resp := &alm.ArchiveResponse{}
+	for _, id := range alarmIds {
+		if alarm, err := alarms.GetAlarm(id, vnic); err == nil && alarm == nil {
+			continue
+		}
+		if err := ArchiveAlarm(id, archivedBy, vnic); err != nil {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("%s: %v", id, err))
+			continue
+		}
+		resp.ArchivedAlarmIds = append(resp.ArchivedAlarmIds, id)
+	}
+	return resp
+}
+
+func (s *ArchiveService) Get(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("archive service only supports POST")
+}
+
+func (s *ArchiveService) Put(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("archive service only supports POST")
+}
+
+func (s *ArchiveService) Patch(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("archive service only supports POST")
+}
+
+func (s *ArchiveService) Delete(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("archive service only supports POST")
+}
+
+func (s *ArchiveService) Failed(elements ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (s *ArchiveService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (s *ArchiveService) WebService() ifs.IWebService {
+	ws := web.New(s.serviceName, s.serviceArea, 0)
+	ws.AddEndpoint(&alm.ArchiveRequest{}, ifs.POST, &alm.ArchiveResponse{})
+	return ws
+}
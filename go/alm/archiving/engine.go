@@ -5,51 +5,169 @@ import (
 	"github.com/saichler/l8alarms/go/alm/alarms"
 	"github.com/saichler/l8alarms/go/alm/archivedalarms"
 	"github.com/saichler/l8alarms/go/alm/archivedevents"
+	"github.com/saichler/l8alarms/go/alm/common"
 	"github.com/saichler/l8alarms/go/alm/events"
 	"github.com/saichler/l8alarms/go/types/alm"
-	"github.com/saichler/l8common/go/common"
+	l8common "github.com/saichler/l8common/go/common"
 	"github.com/saichler/l8srlz/go/serialize/object"
 	"github.com/saichler/l8types/go/ifs"
 	"time"
 )
 
-// ArchiveAlarm archives an alarm and its associated events.
-// If the alarm is a root cause, all symptom alarms are also archived recursively.
+// ArchiveAlarm archives an alarm and its associated events as one unit of work.
+// If the alarm is a root cause, all symptom alarms (and their events) are
+// archived with it, recursively.
+//
+// The archive copies are written first; if any copy fails, the copies of the
+// unit are removed again and the active records are left untouched. Only once
+// every copy is in place are the active records deleted. Copies that already
+// exist are overwritten with the current records, so re-running an
+// interrupted archive resumes it without keeping a stale copy.
 func ArchiveAlarm(alarmId, archivedBy string, vnic ifs.IVNic) error {
-	// 1. Get the alarm
+	plan, err := planArchive(alarmId, vnic)
+	if err != nil {
+		return err
+	}
+
+	if err := plan.copy(time.Now().Unix(), archivedBy, vnic); err != nil {
+		if rbErr := plan.rollback(vnic); rbErr != nil {
+			return fmt.Errorf("failed to archive alarm %s: %v; rollback failed, re-run to resume: %w", alarmId, err, rbErr)
+		}
+		return fmt.Errorf("failed to archive alarm %s, rolled back: %w", alarmId, err)
+	}
+
+	if err := plan.remove(vnic); err != nil {
+		return fmt.Errorf("archived alarm %s but failed to remove active records, re-run to resume: %w", alarmId, err)
+	}
+	return nil
+}
+
+// RollbackArchive removes the archive copies of an alarm, its symptoms and
+// their events while the active records still exist, undoing an interrupted
+// archive instead of resuming it.
+func RollbackArchive(alarmId string, vnic ifs.IVNic) error {
+	plan, err := planArchive(alarmId, vnic)
+	if err != nil {
+		return err
+	}
+	return plan.rollback(vnic)
+}
+
+// archivePlan is the unit of work of one ArchiveAlarm: the alarm, its
+// symptoms (recursively), and the events of each.
+type archivePlan struct {
+	alarms []*alm.Alarm
+	events []*alm.Event
+}
+
+// planArchive collects the active records to archive for an alarm.
+// Symptoms are listed before their root cause so that, if removal is
+// interrupted, the root cause is still there to resume from.
+func planArchive(alarmId string, vnic ifs.IVNic) (*archivePlan, error) {
 	alarm, err := alarms.GetAlarm(alarmId, vnic)
 	if err != nil {
-		return fmt.Errorf("failed to get alarm %s: %w", alarmId, err)
+		return nil, fmt.Errorf("failed to get alarm %s: %w", alarmId, err)
 	}
 	if alarm == nil {
-		return fmt.Errorf("alarm %s not found", alarmId)
+		return nil, fmt.Errorf("alarm %s not found", alarmId)
 	}
 
-	now := time.Now().Unix()
-
-	// 2. Create archived alarm
-	archived := toArchivedAlarm(alarm, now, archivedBy)
-	if err := postArchivedAlarm(archived, vnic); err != nil {
-		return fmt.Errorf("failed to archive alarm %s: %w", alarmId, err)
+	plan := &archivePlan{}
+	visited := map[string]bool{}
+	if err := plan.add(alarm, visited, vnic); err != nil {
+		return nil, err
 	}
+	return plan, nil
+}
 
-	// 3. Archive associated events
-	if err := archiveEventsForAlarm(alarmId, now, archivedBy, vnic); err != nil {
-		return fmt.Errorf("failed to archive events for alarm %s: %w", alarmId, err)
+func (p *archivePlan) add(alarm *alm.Alarm, visited map[string]bool, vnic ifs.IVNic) error {
+	if visited[alarm.AlarmId] {
+		return nil
 	}
+	visited[alarm.AlarmId] = true
 
-	// 4. If root cause, recursively archive symptom alarms
 	if alarm.IsRootCause && alarm.SymptomCount > 0 {
-		if err := archiveSymptoms(alarmId, archivedBy, vnic); err != nil {
-			return fmt.Errorf("failed to archive symptoms of %s: %w", alarmId, err)
+		query := fmt.Sprintf("select * from Alarm where RootCauseAlarmId=%s", alarm.AlarmId)
+		symptomsRaw, err := l8common.GetEntitiesByQuery(alarms.ServiceName, alarms.ServiceArea, query, vnic)
+		if err != nil {
+			return fmt.Errorf("failed to get symptoms of %s: %w", alarm.AlarmId, err)
+		}
+		for _, raw := range symptomsRaw {
+			if err := p.add(raw.(*alm.Alarm), visited, vnic); err != nil {
+				return err
+			}
+		}
+	}
+
+	query := fmt.Sprintf("select * from Event where AlarmId=%s", alarm.AlarmId)
+	evtsRaw, err := l8common.GetEntitiesByQuery(events.ServiceName, events.ServiceArea, query, vnic)
+	if err != nil {
+		return fmt.Errorf("failed to get events for alarm %s: %w", alarm.AlarmId, err)
+	}
+	for _, raw := range evtsRaw {
+		p.events = append(p.events, raw.(*alm.Event))
+	}
+	p.alarms = append(p.alarms, alarm)
+	return nil
+}
+
+// copy writes the archive copy of every record in the plan, overwriting
+// copies left by an earlier, interrupted attempt with the current record.
+func (p *archivePlan) copy(archivedAt int64, archivedBy string, vnic ifs.IVNic) error {
+	for _, evt := range p.events {
+		existing, err := archivedevents.GetArchivedEvent(evt.EventId, vnic)
+		if err != nil {
+			return fmt.Errorf("failed to check archived event %s: %w", evt.EventId, err)
+		}
+		if err := writeArchivedEvent(toArchivedEvent(evt, archivedAt, archivedBy), existing != nil, vnic); err != nil {
+			return fmt.Errorf("failed to archive event %s: %w", evt.EventId, err)
 		}
 	}
+	for _, alarm := range p.alarms {
+		existing, err := archivedalarms.GetArchivedAlarm(alarm.AlarmId, vnic)
+		if err != nil {
+			return fmt.Errorf("failed to check archived alarm %s: %w", alarm.AlarmId, err)
+		}
+		if err := writeArchivedAlarm(toArchivedAlarm(alarm, archivedAt, archivedBy), existing != nil, vnic); err != nil {
+			return fmt.Errorf("failed to archive alarm %s: %w", alarm.AlarmId, err)
+		}
+	}
+	return nil
+}
 
-	// 5. Delete the active alarm
-	if err := deleteAlarm(alarmId, vnic); err != nil {
-		return fmt.Errorf("failed to delete active alarm %s: %w", alarmId, err)
+// rollback removes the archive copies of every record in the plan. The
+// active records are untouched, so the unit can be archived again later.
+func (p *archivePlan) rollback(vnic ifs.IVNic) error {
+	for _, evt := range p.events {
+		if err := deleteArchivedEvent(evt.EventId, vnic); err != nil {
+			return err
+		}
+	}
+	for _, alarm := range p.alarms {
+		if err := DeleteArchivedAlarm(alarm.AlarmId, vnic); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
+// remove deletes the active records of the plan, each alarm after its events
+// and symptoms. Only the planned events are deleted: an event that attached to
+// an alarm after planning was never copied, so it is left in place.
+func (p *archivePlan) remove(vnic ifs.IVNic) error {
+	for _, alarm := range p.alarms {
+		for _, evt := range p.events {
+			if evt.AlarmId != alarm.AlarmId {
+				continue
+			}
+			if err := deleteEvent(evt.EventId, vnic); err != nil {
+				return fmt.Errorf("failed to delete event %s of alarm %s: %w", evt.EventId, alarm.AlarmId, err)
+			}
+		}
+		if err := deleteAlarm(alarm.AlarmId, vnic); err != nil {
+			return fmt.Errorf("failed to delete active alarm %s: %w", alarm.AlarmId, err)
+		}
+	}
 	return nil
 }
 
@@ -87,6 +205,8 @@ func toArchivedAlarm(a *alm.Alarm, archivedAt int64, archivedBy string) *alm.Arc
 		StateHistory:     a.StateHistory,
 		ArchivedAt:       archivedAt,
 		ArchivedBy:       archivedBy,
+		AssignedTo:       a.AssignedTo,
+		AssignedAt:       a.AssignedAt,
 	}
 }
 
@@ -114,60 +234,36 @@ func toArchivedEvent(e *alm.Event, archivedAt int64, archivedBy string) *alm.Arc
 	}
 }
 
-func archiveEventsForAlarm(alarmId string, archivedAt int64, archivedBy string, vnic ifs.IVNic) error {
-	query := fmt.Sprintf("select * from Event where AlarmId=%s", alarmId)
-	evtsRaw, err := common.GetEntitiesByQuery(events.ServiceName, events.ServiceArea, query, vnic)
-	if err != nil {
-		return err
-	}
-
-	for _, raw := range evtsRaw {
-		evt := raw.(*alm.Event)
-		archived := toArchivedEvent(evt, archivedAt, archivedBy)
-		if err := postArchivedEvent(archived, vnic); err != nil {
-			return err
-		}
-		if err := deleteEvent(evt.EventId, vnic); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func archiveSymptoms(rootAlarmId, archivedBy string, vnic ifs.IVNic) error {
-	query := fmt.Sprintf("select * from Alarm where RootCauseAlarmId=%s", rootAlarmId)
-	symptomsRaw, err := common.GetEntitiesByQuery(alarms.ServiceName, alarms.ServiceArea, query, vnic)
-	if err != nil {
-		return err
-	}
-
-	for _, raw := range symptomsRaw {
-		symptom := raw.(*alm.Alarm)
-		if err := ArchiveAlarm(symptom.AlarmId, archivedBy, vnic); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func postArchivedAlarm(archived *alm.ArchivedAlarm, vnic ifs.IVNic) error {
+// writeArchivedAlarm posts an archive copy, or replaces the existing one
+// through an internal PUT.
+func writeArchivedAlarm(archived *alm.ArchivedAlarm, exists bool, vnic ifs.IVNic) error {
 	handler, ok := archivedalarms.ArchivedAlarms(vnic)
 	if !ok {
 		return fmt.Errorf("ArchivedAlarm service not available")
 	}
-	resp := handler.Post(object.New(nil, archived), vnic)
-	if resp.Error() != nil {
-		return resp.Error()
-	}
-	return nil
+	return write(handler, archived, exists, vnic)
 }
 
-func postArchivedEvent(archived *alm.ArchivedEvent, vnic ifs.IVNic) error {
+// writeArchivedEvent posts an archive copy, or replaces the existing one
+// through an internal PUT.
+func writeArchivedEvent(archived *alm.ArchivedEvent, exists bool, vnic ifs.IVNic) error {
 	handler, ok := archivedevents.ArchivedEvents(vnic)
 	if !ok {
 		return fmt.Errorf("ArchivedEvent service not available")
 	}
-	resp := handler.Post(object.New(nil, archived), vnic)
+	return write(handler, archived, exists, vnic)
+}
+
+func write(handler ifs.IServiceHandler, archived interface{}, exists bool, vnic ifs.IVNic) error {
+	var resp ifs.IElements
+	if !exists {
+		resp = handler.Post(object.New(nil, archived), vnic)
+	} else {
+		// Archives are immutable to everyone but the archiver
+		common.Internal(archived, func() {
+			resp = handler.Put(object.New(nil, archived), vnic)
+		})
+	}
 	if resp.Error() != nil {
 		return resp.Error()
 	}
@@ -179,16 +275,7 @@ func deleteAlarm(alarmId string, vnic ifs.IVNic) error {
 	if !ok {
 		return fmt.Errorf("Alarm service not available")
 	}
-	query := fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId)
-	elems, err := object.NewQuery(query, vnic.Resources())
-	if err != nil {
-		return err
-	}
-	resp := handler.Delete(elems, vnic)
-	if resp.Error() != nil {
-		return resp.Error()
-	}
-	return nil
+	return deleteByQuery(handler, fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId), vnic)
 }
 
 func deleteEvent(eventId string, vnic ifs.IVNic) error {
@@ -196,12 +283,38 @@ func deleteEvent(eventId string, vnic ifs.IVNic) error {
 	if !ok {
 		return fmt.Errorf("Event service not available")
 	}
-	query := fmt.Sprintf("select * from Event where EventId=%s", eventId)
+	return deleteByQuery(handler, fmt.Sprintf("select * from Event where EventId=%s", eventId), vnic)
+}
+
+// DeleteArchivedAlarm deletes an archived alarm as the archiver. Archived
+// alarms are immutable to operators, so this is the only way to remove one
+// outside a purge, e.g. to clean up after a test.
+func DeleteArchivedAlarm(alarmId string, vnic ifs.IVNic) error {
+	handler, ok := archivedalarms.ArchivedAlarms(vnic)
+	if !ok {
+		return fmt.Errorf("ArchivedAlarm service not available")
+	}
+	return deleteByQuery(handler, fmt.Sprintf("select * from ArchivedAlarm where AlarmId=%s", alarmId), vnic)
+}
+
+func deleteArchivedEvent(eventId string, vnic ifs.IVNic) error {
+	handler, ok := archivedevents.ArchivedEvents(vnic)
+	if !ok {
+		return fmt.Errorf("ArchivedEvent service not available")
+	}
+	return deleteByQuery(handler, fmt.Sprintf("select * from ArchivedEvent where EventId=%s", eventId), vnic)
+}
+
+func deleteByQuery(handler ifs.IServiceHandler, query string, vnic ifs.IVNic) error {
 	elems, err := object.NewQuery(query, vnic.Resources())
 	if err != nil {
 		return err
 	}
-	resp := handler.Delete(elems, vnic)
+	// Events and archives are immutable to everyone but the archiver
+	var resp ifs.IElements
+	common.Internal(elems.Element(), func() {
+		resp = handler.Delete(elems, vnic)
+	})
 	if resp.Error() != nil {
 		return resp.Error()
 	}
@@ -0,0 +1,76 @@
+package archiving
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// exportLine is one line of a purge export file.
+type exportLine struct {
+	Kind   string          `json:"kind"`
+	Record json.RawMessage `json:"record"`
+}
+
+// exportPurged writes the records about to be purged to
+// EXPORT_DIRECTORY/<subdir>/<policyId>-<time>.jsonl.gz, one JSON object per
+// line, and returns the file's path. A partially written file is removed on
+// failure.
+func exportPurged(subdir, policyId string, now time.Time, alarms []*alm.ArchivedAlarm, events []*alm.ArchivedEvent) (string, error) {
+	if err := common.SafeName("exportDirectory", subdir); err != nil {
+		return "", err
+	}
+	if err := common.SafeName("policyId", policyId); err != nil {
+		return "", err
+	}
+	dir := filepath.Join(common.EXPORT_DIRECTORY, subdir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.jsonl.gz", policyId, now.UTC().Format("20060102T150405Z")))
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+
+	err = writeExport(f, alarms, events)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return "", err
+	}
+	return path, nil
+}
+
+func writeExport(f *os.File, alarms []*alm.ArchivedAlarm, events []*alm.ArchivedEvent) error {
+	zw := gzip.NewWriter(f)
+	enc := json.NewEncoder(zw)
+	write := func(kind string, record proto.Message) error {
+		data, err := protojson.Marshal(record)
+		if err != nil {
+			return err
+		}
+		return enc.Encode(exportLine{Kind: kind, Record: data})
+	}
+
+	for _, a := range alarms {
+		if err := write("ArchivedAlarm", a); err != nil {
+			return err
+		}
+	}
+	for _, e := range events {
+		if err := write("ArchivedEvent", e); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
@@ -0,0 +1,185 @@
+package archiving
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/archivedalarms"
+	"github.com/saichler/l8alarms/go/alm/archivedevents"
+	"github.com/saichler/l8alarms/go/alm/archivepolicies"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"time"
+)
+
+// purgeInterval is how often the archive retention policies are enforced.
+const purgeInterval = time.Hour
+
+// pageSize is how many archived records are fetched per purge query.
+const pageSize = 500
+
+// StartPurger enforces the ACTIVE archive retention policies in the background.
+func StartPurger(vnic ifs.IVNic) {
+	go func() {
+		ticker := time.NewTicker(purgeInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			RunPurge(vnic)
+		}
+	}()
+}
+
+// RunPurge enforces every ACTIVE archive retention policy.
+func RunPurge(vnic ifs.IVNic) {
+	policiesRaw, err := common.GetEntitiesByQuery(
+		archivepolicies.ServiceName, archivepolicies.ServiceArea,
+		fmt.Sprintf("select * from ArchiveRetentionPolicy where Status=%d",
+			alm.AlmPolicyStatus_ALM_POLICY_STATUS_ACTIVE),
+		vnic,
+	)
+	if err != nil {
+		fmt.Printf("[archiving] failed to query archive retention policies: %v\n", err)
+		return
+	}
+	for _, raw := range policiesRaw {
+		policy := raw.(*alm.ArchiveRetentionPolicy)
+		if err := Purge(policy, vnic); err != nil {
+			fmt.Printf("[archiving] purge failed for policy %s: %v\n", policy.PolicyId, err)
+		}
+	}
+}
+
+// Purge removes the archived alarms and events matched by the policy and
+// records the outcome on the policy. In dry-run mode the matches are only
+// counted and reported. If the policy exports purged records, nothing is
+// deleted unless the export file was written.
+func Purge(policy *alm.ArchiveRetentionPolicy, vnic ifs.IVNic) error {
+	now := time.Now()
+	cutoff := now.Unix() - int64(policy.MaxAgeDays)*86400
+
+	var alarmsToPurge []*alm.ArchivedAlarm
+	var eventsToPurge []*alm.ArchivedEvent
+	var err error
+	if policy.PurgeAlarms {
+		if alarmsToPurge, err = expiredArchivedAlarms(policy, cutoff, vnic); err != nil {
+			return err
+		}
+	}
+	if policy.PurgeEvents {
+		if eventsToPurge, err = expiredArchivedEvents(policy, cutoff, vnic); err != nil {
+			return err
+		}
+	}
+
+	policy.LastRunAt = now.Unix()
+	policy.LastAlarmCount = int32(len(alarmsToPurge))
+	policy.LastEventCount = int32(len(eventsToPurge))
+	policy.LastExportFile = ""
+
+	if policy.DryRun {
+		for _, a := range alarmsToPurge {
+			fmt.Printf("[archiving] dry run: policy %s would purge archived alarm %s\n", policy.PolicyId, a.AlarmId)
+		}
+		for _, e := range eventsToPurge {
+			fmt.Printf("[archiving] dry run: policy %s would purge archived event %s\n", policy.PolicyId, e.EventId)
+		}
+	} else if len(alarmsToPurge) > 0 || len(eventsToPurge) > 0 {
+		if policy.ExportBeforePurge {
+			file, err := exportPurged(policy.ExportDirectory, policy.PolicyId, now, alarmsToPurge, eventsToPurge)
+			if err != nil {
+				return fmt.Errorf("failed to export purged records: %w", err)
+			}
+			policy.LastExportFile = file
+		}
+		for _, e := range eventsToPurge {
+			if err := deleteArchivedEvent(e.EventId, vnic); err != nil {
+				return fmt.Errorf("failed to purge archived event %s: %w", e.EventId, err)
+			}
+		}
+		for _, a := range alarmsToPurge {
+			if err := DeleteArchivedAlarm(a.AlarmId, vnic); err != nil {
+				return fmt.Errorf("failed to purge archived alarm %s: %w", a.AlarmId, err)
+			}
+		}
+	}
+
+	return common.PutEntity(archivepolicies.ServiceName, archivepolicies.ServiceArea, policy, vnic)
+}
+
+// expiredArchivedAlarms returns the archived alarms of the policy's
+// definitions and severities archived before cutoff.
+func expiredArchivedAlarms(policy *alm.ArchiveRetentionPolicy, cutoff int64, vnic ifs.IVNic) ([]*alm.ArchivedAlarm, error) {
+	var result []*alm.ArchivedAlarm
+	for _, query := range purgeQueries("ArchivedAlarm", policy, cutoff) {
+		for page := 0; ; page++ {
+			raws, err := common.GetEntitiesByQuery(archivedalarms.ServiceName, archivedalarms.ServiceArea,
+				pageQuery(query, "AlarmId", page), vnic)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query archived alarms: %w", err)
+			}
+			for _, raw := range raws {
+				result = append(result, raw.(*alm.ArchivedAlarm))
+			}
+			if len(raws) < pageSize {
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// expiredArchivedEvents returns the archived events of the policy's
+// definitions and severities archived before cutoff.
+func expiredArchivedEvents(policy *alm.ArchiveRetentionPolicy, cutoff int64, vnic ifs.IVNic) ([]*alm.ArchivedEvent, error) {
+	var result []*alm.ArchivedEvent
+	for _, query := range purgeQueries("ArchivedEvent", policy, cutoff) {
+		for page := 0; ; page++ {
+			raws, err := common.GetEntitiesByQuery(archivedevents.ServiceName, archivedevents.ServiceArea,
+				pageQuery(query, "EventId", page), vnic)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query archived events: %w", err)
+			}
+			for _, raw := range raws {
+				result = append(result, raw.(*alm.ArchivedEvent))
+			}
+			if len(raws) < pageSize {
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// purgeQueries returns one query per alarm definition and severity of the
+// policy, selecting the records archived before cutoff. A policy that lists
+// no definitions or no severities is not bounded by them.
+func purgeQueries(table string, policy *alm.ArchiveRetentionPolicy, cutoff int64) []string {
+	defIds := policy.AlarmDefinitionIds
+	if len(defIds) == 0 {
+		defIds = []string{""}
+	}
+	severities := policy.Severities
+	if len(severities) == 0 {
+		severities = []l8events.Severity{l8events.Severity_SEVERITY_UNSPECIFIED}
+	}
+	var queries []string
+	for _, defId := range defIds {
+		for _, severity := range severities {
+			query := fmt.Sprintf("select * from %s where ArchivedAt>0 and ArchivedAt<%d", table, cutoff)
+			if defId != "" {
+				query += " and DefinitionId=" + defId
+			}
+			if severity != l8events.Severity_SEVERITY_UNSPECIFIED {
+				query += fmt.Sprintf(" and Severity=%d", severity)
+			}
+			queries = append(queries, query)
+		}
+	}
+	return queries
+}
+
+// pageQuery selects one page of a purge query, sorted by the primary key so
+// paging is stable.
+func pageQuery(query, primaryKey string, page int) string {
+	return fmt.Sprintf("%s limit %d page %d sort-by %s", query, pageSize, page, primaryKey)
+}
@@ -0,0 +1,286 @@
+package archiving
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/alarms"
+	"github.com/saichler/l8alarms/go/alm/archivedalarms"
+	"github.com/saichler/l8alarms/go/alm/archivedevents"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/alm/events"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8common "github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"time"
+)
+
+// RestoreReason is the reason of the state_history entry recorded on restore.
+const RestoreReason = "restored from archive"
+
+// RestoreAlarm is the reverse of ArchiveAlarm: it rebuilds the active alarm,
+// its archived symptoms (recursively) and their events from the archive, then
+// removes the archive copies. Root cause links and symptom counts are kept as
+// archived, and each restored alarm gets a state_history entry for the restore.
+// Restored records are written quietly (common.Quiet), without the engine
+// hooks, so restoring neither touches other alarms nor notifies again.
+//
+// The active records are written first; if any write fails, the records this
+// attempt created are removed again and the archive is left untouched. Active
+// records that already exist are kept, so re-running an interrupted restore
+// resumes it.
+func RestoreAlarm(alarmId, restoredBy string, vnic ifs.IVNic) ([]string, error) {
+	plan, err := planRestore(alarmId, vnic)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := plan.restore(time.Now().Unix(), restoredBy, vnic)
+	if err != nil {
+		if rbErr := created.removeActive(vnic); rbErr != nil {
+			return nil, fmt.Errorf("failed to restore alarm %s: %v; rollback failed, re-run to resume: %w", alarmId, err, rbErr)
+		}
+		return nil, fmt.Errorf("failed to restore alarm %s, rolled back: %w", alarmId, err)
+	}
+
+	if err := plan.removeArchived(vnic); err != nil {
+		return nil, fmt.Errorf("restored alarm %s but failed to remove archive copies, re-run to resume: %w", alarmId, err)
+	}
+
+	ids := make([]string, 0, len(plan.alarms))
+	for _, a := range plan.alarms {
+		ids = append(ids, a.AlarmId)
+	}
+	return ids, nil
+}
+
+// restorePlan is the unit of work of one RestoreAlarm: the archived alarm,
+// its archived symptoms (recursively), and the archived events of each.
+type restorePlan struct {
+	alarms []*alm.ArchivedAlarm
+	events []*alm.ArchivedEvent
+}
+
+// restoredRecords are the active records written by one restore attempt.
+type restoredRecords struct {
+	alarmIds []string
+	eventIds []string
+}
+
+// planRestore collects the archived records to restore for an alarm.
+// Root causes are listed before their symptoms, so restored symptoms link to
+// an existing alarm.
+func planRestore(alarmId string, vnic ifs.IVNic) (*restorePlan, error) {
+	archived, err := archivedalarms.GetArchivedAlarm(alarmId, vnic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archived alarm %s: %w", alarmId, err)
+	}
+	if archived == nil {
+		return nil, fmt.Errorf("archived alarm %s not found", alarmId)
+	}
+
+	plan := &restorePlan{}
+	visited := map[string]bool{}
+	if err := plan.add(archived, visited, vnic); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (p *restorePlan) add(archived *alm.ArchivedAlarm, visited map[string]bool, vnic ifs.IVNic) error {
+	if visited[archived.AlarmId] {
+		return nil
+	}
+	visited[archived.AlarmId] = true
+	p.alarms = append(p.alarms, archived)
+
+	query := fmt.Sprintf("select * from ArchivedEvent where AlarmId=%s", archived.AlarmId)
+	evtsRaw, err := l8common.GetEntitiesByQuery(archivedevents.ServiceName, archivedevents.ServiceArea, query, vnic)
+	if err != nil {
+		return fmt.Errorf("failed to get archived events for alarm %s: %w", archived.AlarmId, err)
+	}
+	for _, raw := range evtsRaw {
+		p.events = append(p.events, raw.(*alm.ArchivedEvent))
+	}
+
+	if archived.IsRootCause && archived.SymptomCount > 0 {
+		query := fmt.Sprintf("select * from ArchivedAlarm where RootCauseAlarmId=%s", archived.AlarmId)
+		symptomsRaw, err := l8common.GetEntitiesByQuery(archivedalarms.ServiceName, archivedalarms.ServiceArea, query, vnic)
+		if err != nil {
+			return fmt.Errorf("failed to get archived symptoms of %s: %w", archived.AlarmId, err)
+		}
+		for _, raw := range symptomsRaw {
+			if err := p.add(raw.(*alm.ArchivedAlarm), visited, vnic); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// restore writes the active record of every archived record in the plan,
+// keeping active records left by an earlier, interrupted attempt, and returns
+// the records it created.
+func (p *restorePlan) restore(restoredAt int64, restoredBy string, vnic ifs.IVNic) (*restoredRecords, error) {
+	created := &restoredRecords{}
+	for _, archived := range p.alarms {
+		existing, err := alarms.GetAlarm(archived.AlarmId, vnic)
+		if err != nil {
+			return created, fmt.Errorf("failed to check alarm %s: %w", archived.AlarmId, err)
+		}
+		if existing != nil {
+			continue
+		}
+		if err := postAlarm(fromArchivedAlarm(archived, restoredAt, restoredBy), vnic); err != nil {
+			return created, fmt.Errorf("failed to restore alarm %s: %w", archived.AlarmId, err)
+		}
+		created.alarmIds = append(created.alarmIds, archived.AlarmId)
+	}
+	for _, archived := range p.events {
+		existing, err := events.GetEvent(archived.EventId, vnic)
+		if err != nil {
+			return created, fmt.Errorf("failed to check event %s: %w", archived.EventId, err)
+		}
+		if existing != nil {
+			continue
+		}
+		if err := postEvent(fromArchivedEvent(archived), vnic); err != nil {
+			return created, fmt.Errorf("failed to restore event %s: %w", archived.EventId, err)
+		}
+		created.eventIds = append(created.eventIds, archived.EventId)
+	}
+	return created, nil
+}
+
+// removeArchived deletes the archive copies of the plan, each alarm after its
+// events and symptoms, so an interrupted removal can be resumed from the root.
+func (p *restorePlan) removeArchived(vnic ifs.IVNic) error {
+	for _, archived := range p.events {
+		if err := deleteArchivedEvent(archived.EventId, vnic); err != nil {
+			return fmt.Errorf("failed to delete archived event %s: %w", archived.EventId, err)
+		}
+	}
+	for i := len(p.alarms) - 1; i >= 0; i-- {
+		if err := DeleteArchivedAlarm(p.alarms[i].AlarmId, vnic); err != nil {
+			return fmt.Errorf("failed to delete archived alarm %s: %w", p.alarms[i].AlarmId, err)
+		}
+	}
+	return nil
+}
+
+// removeActive deletes the active records created by a failed restore attempt.
+func (r *restoredRecords) removeActive(vnic ifs.IVNic) error {
+	handler, ok := events.Events(vnic)
+	if !ok {
+		return fmt.Errorf("Event service not available")
+	}
+	for _, id := range r.eventIds {
+		if err := deleteByQuery(handler, fmt.Sprintf("select * from Event where EventId=%s", id), vnic); err != nil {
+			return err
+		}
+	}
+	for i := len(r.alarmIds) - 1; i >= 0; i-- {
+		if err := deleteAlarm(r.alarmIds[i], vnic); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fromArchivedAlarm(a *alm.ArchivedAlarm, restoredAt int64, restoredBy string) *alm.Alarm {
+	history := append([]*l8events.AlarmStateChange{}, a.StateHistory...)
+	history = append(history, &l8events.AlarmStateChange{
+		FromState: a.State,
+		ToState:   a.State,
+		ChangedBy: restoredBy,
+		Reason:    RestoreReason,
+		ChangedAt: restoredAt,
+	})
+	return &alm.Alarm{
+		AlarmId:           a.AlarmId,
+		DefinitionId:      a.DefinitionId,
+		Name:              a.Name,
+		Description:       a.Description,
+		State:             a.State,
+		Severity:          a.Severity,
+		OriginalSeverity:  a.OriginalSeverity,
+		NodeId:            a.NodeId,
+		NodeName:          a.NodeName,
+		LinkId:            a.LinkId,
+		Location:          a.Location,
+		SourceIdentifier:  a.SourceIdentifier,
+		RootCauseAlarmId:  a.RootCauseAlarmId,
+		CorrelationRuleId: a.CorrelationRuleId,
+		IsRootCause:       a.IsRootCause,
+		SymptomCount:      a.SymptomCount,
+		FirstOccurrence:   a.FirstOccurrence,
+		LastOccurrence:    a.LastOccurrence,
+		AcknowledgedAt:    a.AcknowledgedAt,
+		AcknowledgedBy:    a.AcknowledgedBy,
+		ClearedAt:         a.ClearedAt,
+		ClearedBy:         a.ClearedBy,
+		OccurrenceCount:   a.OccurrenceCount,
+		DedupKey:          a.DedupKey,
+		IsSuppressed:      a.IsSuppressed,
+		SuppressedBy:      a.SuppressedBy,
+		EventId:           a.EventId,
+		Attributes:        a.Attributes,
+		Notes:             a.Notes,
+		StateHistory:      history,
+		AssignedTo:        a.AssignedTo,
+		AssignedAt:        a.AssignedAt,
+	}
+}
+
+func fromArchivedEvent(e *alm.ArchivedEvent) *alm.Event {
+	return &alm.Event{
+		EventId:          e.EventId,
+		EventType:        e.EventType,
+		ProcessingState:  e.ProcessingState,
+		NodeId:           e.NodeId,
+		NodeName:         e.NodeName,
+		SourceIdentifier: e.SourceIdentifier,
+		Severity:         e.Severity,
+		Message:          e.Message,
+		RawData:          e.RawData,
+		Category:         e.Category,
+		Subcategory:      e.Subcategory,
+		AlarmId:          e.AlarmId,
+		DefinitionId:     e.DefinitionId,
+		OccurredAt:       e.OccurredAt,
+		ReceivedAt:       e.ReceivedAt,
+		ProcessedAt:      e.ProcessedAt,
+		Attributes:       e.Attributes,
+	}
+}
+
+// postAlarm writes a restored alarm as is: a quiet POST skips the engine
+// hooks, so it is not deduplicated, correlated, suppressed, notified or
+// escalated again.
+func postAlarm(alarm *alm.Alarm, vnic ifs.IVNic) error {
+	handler, ok := alarms.Alarms(vnic)
+	if !ok {
+		return fmt.Errorf("Alarm service not available")
+	}
+	return postQuiet(handler, alarm, vnic)
+}
+
+// postEvent writes a restored event as is, without normalizing or processing it.
+func postEvent(event *alm.Event, vnic ifs.IVNic) error {
+	handler, ok := events.Events(vnic)
+	if !ok {
+		return fmt.Errorf("Event service not available")
+	}
+	return postQuiet(handler, event, vnic)
+}
+
+func postQuiet(handler ifs.IServiceHandler, record interface{}, vnic ifs.IVNic) error {
+	var resp ifs.IElements
+	common.Quiet(record, func() {
+		resp = handler.Post(object.New(nil, record), vnic)
+	})
+	if resp.Error() != nil {
+		return resp.Error()
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package archiving
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/alarms"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"time"
+)
+
+// RetentionArchivedBy is recorded as archived_by for alarms archived by retention.
+const RetentionArchivedBy = "system:retention"
+
+// retentionInterval is how often cleared alarms are checked against the retention age.
+const retentionInterval = 30 * time.Second
+
+// StartRetention archives, in the background, CLEARED alarms that were cleared
+// more than retentionSeconds ago. A retentionSeconds of 0 or less disables it.
+func StartRetention(vnic ifs.IVNic, retentionSeconds int64) {
+	if retentionSeconds <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(retentionInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			RunRetention(vnic, retentionSeconds)
+		}
+	}()
+}
+
+// RunRetention archives every CLEARED alarm cleared more than retentionSeconds
+// ago, together with its events and symptoms. Alarms without cleared_at fall
+// back to their last occurrence.
+func RunRetention(vnic ifs.IVNic, retentionSeconds int64) *alm.ArchiveResponse {
+	alarmsRaw, err := common.GetEntitiesByQuery(alarms.ServiceName, alarms.ServiceArea,
+		fmt.Sprintf("select * from Alarm where State=%d", l8events.AlarmState_ALARM_STATE_CLEARED),
+		vnic,
+	)
+	if err != nil {
+		fmt.Printf("[archiving] failed to query cleared alarms: %v\n", err)
+		return &alm.ArchiveResponse{}
+	}
+
+	cutoff := time.Now().Unix() - retentionSeconds
+	var expired []string
+	for _, raw := range alarmsRaw {
+		alarm := raw.(*alm.Alarm)
+		clearedAt := alarm.ClearedAt
+		if clearedAt == 0 {
+			clearedAt = alarm.LastOccurrence
+		}
+		if clearedAt == 0 || clearedAt > cutoff {
+			continue
+		}
+		expired = append(expired, alarm.AlarmId)
+	}
+
+	resp := ArchiveAlarms(expired, RetentionArchivedBy, vnic)
+	for _, e := range resp.Errors {
+		fmt.Printf("[archiving] retention failed to archive alarm %s\n", e)
+	}
+	return resp
+}
@@ -0,0 +1,67 @@
+package autoclear
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/dedup"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8types/go/ifs"
+	"regexp"
+	"sort"
+)
+
+// ClearByEvent clears the open alarms that the event resolves. The event
+// resolves an alarm when it matches the clear_event_pattern of the alarm's
+// auto-clear definition and has the same node and dedup key.
+// It returns the first definition whose clear pattern matched with an open
+// alarm, and the alarms it cleared; nil if the event is not a clear event.
+func ClearByEvent(event *alm.Event, definitions []*alm.AlarmDefinition, vnic ifs.IVNic) (*alm.AlarmDefinition, []*alm.Alarm, error) {
+	sorted := make([]*alm.AlarmDefinition, len(definitions))
+	copy(sorted, definitions)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].DefinitionId < sorted[j].DefinitionId
+	})
+
+	for _, def := range sorted {
+		if !matchesClearPattern(event, def) {
+			continue
+		}
+		open, err := openAlarms(fmt.Sprintf("select * from Alarm where DefinitionId=%s and NodeId=%s",
+			def.DefinitionId, event.NodeId), vnic)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to query alarms to clear: %w", err)
+		}
+
+		key := dedup.EventKey(def, event)
+		var cleared []*alm.Alarm
+		for _, alarm := range open {
+			if alarm.DedupKey != key {
+				continue
+			}
+			if err := ClearAlarm(alarm, "clear event "+event.EventId, vnic); err != nil {
+				return def, cleared, fmt.Errorf("failed to clear alarm %s: %w", alarm.AlarmId, err)
+			}
+			cleared = append(cleared, alarm)
+		}
+		if len(cleared) > 0 {
+			return def, cleared, nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// matchesClearPattern checks the event against an auto-clear definition's
+// clear_event_pattern and event_type_filter.
+func matchesClearPattern(event *alm.Event, def *alm.AlarmDefinition) bool {
+	if !def.AutoClearEnabled || def.ClearEventPattern == "" {
+		return false
+	}
+	if def.EventTypeFilter != alm.AlmEventType_ALM_EVENT_TYPE_UNSPECIFIED &&
+		def.EventTypeFilter != event.EventType {
+		return false
+	}
+	pattern, err := regexp.Compile(def.ClearEventPattern)
+	if err != nil {
+		return false
+	}
+	return pattern.MatchString(event.Message)
+}
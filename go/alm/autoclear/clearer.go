@@ -0,0 +1,106 @@
+package autoclear
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/alarmdefinitions"
+	"github.com/saichler/l8alarms/go/alm/alarms"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8common "github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"time"
+)
+
+// ClearedBy is recorded as cleared_by and in state_history for every auto-clear.
+const ClearedBy = "system:auto-clear"
+
+// sweepInterval is how often alarms are checked against auto_clear_seconds.
+const sweepInterval = 30 * time.Second
+
+// Activate starts the background sweep that clears alarms whose last_occurrence
+// is older than their definition's auto_clear_seconds.
+func Activate(vnic ifs.IVNic) {
+	go func() {
+		ticker := time.NewTicker(sweepInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			Sweep(vnic)
+		}
+	}()
+}
+
+// Sweep clears every non-cleared alarm of an auto-clear definition that has
+// not re-occurred within the definition's auto_clear_seconds.
+// Alarms without any occurrence time are left alone.
+func Sweep(vnic ifs.IVNic) {
+	defsRaw, err := l8common.GetEntitiesByQuery(
+		alarmdefinitions.ServiceName, alarmdefinitions.ServiceArea,
+		fmt.Sprintf("select * from AlarmDefinition where Status=%d",
+			alm.AlarmDefinitionStatus_ALARM_DEFINITION_STATUS_ACTIVE),
+		vnic,
+	)
+	if err != nil {
+		fmt.Printf("[autoclear] failed to query alarm definitions: %v\n", err)
+		return
+	}
+
+	now := time.Now().Unix()
+	for _, raw := range defsRaw {
+		def := raw.(*alm.AlarmDefinition)
+		if !def.AutoClearEnabled || def.AutoClearSeconds <= 0 {
+			continue
+		}
+		open, err := openAlarms(fmt.Sprintf("select * from Alarm where DefinitionId=%s", def.DefinitionId), vnic)
+		if err != nil {
+			fmt.Printf("[autoclear] failed to query alarms for definition %s: %v\n", def.DefinitionId, err)
+			continue
+		}
+		cutoff := now - int64(def.AutoClearSeconds)
+		for _, alarm := range open {
+			seen := lastSeen(alarm)
+			if seen == 0 || seen > cutoff {
+				continue
+			}
+			reason := fmt.Sprintf("no occurrence within %d seconds", def.AutoClearSeconds)
+			if err := ClearAlarm(alarm, reason, vnic); err != nil {
+				fmt.Printf("[autoclear] failed to clear alarm %s: %v\n", alarm.AlarmId, err)
+			}
+		}
+	}
+}
+
+// ClearAlarm moves the alarm to CLEARED through common.TransitionAlarm,
+// recording ClearedBy, and persists it.
+func ClearAlarm(alarm *alm.Alarm, reason string, vnic ifs.IVNic) error {
+	if err := common.TransitionAlarm(alarm, l8events.AlarmState_ALARM_STATE_CLEARED, ClearedBy, common.SourceAutoClear, reason); err != nil {
+		return err
+	}
+	return alarms.PutInternal(alarm, vnic)
+}
+
+// openAlarms runs the alarm query and returns the alarms that are not cleared.
+func openAlarms(query string, vnic ifs.IVNic) ([]*alm.Alarm, error) {
+	alarmsRaw, err := l8common.GetEntitiesByQuery(alarms.ServiceName, alarms.ServiceArea, query, vnic)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*alm.Alarm, 0, len(alarmsRaw))
+	for _, raw := range alarmsRaw {
+		a := raw.(*alm.Alarm)
+		if a.State == l8events.AlarmState_ALARM_STATE_CLEARED ||
+			a.State == l8events.AlarmState_ALARM_STATE_UNSPECIFIED {
+			continue
+		}
+		result = append(result, a)
+	}
+	return result, nil
+}
+
+// lastSeen returns when the alarm last occurred, falling back to its first occurrence.
+func lastSeen(alarm *alm.Alarm) int64 {
+	if alarm.LastOccurrence != 0 {
+		return alarm.LastOccurrence
+	}
+	return alarm.FirstOccurrence
+}
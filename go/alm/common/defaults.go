@@ -7,3 +7,89 @@ const (
 
 var DB_CREDS = "admin"
 var DB_NAME = "admin"
+
+// ARCHIVE_RETENTION_SECONDS is how long CLEARED alarms stay in the Alarm table
+// before they are archived. 0 disables retention archiving.
+var ARCHIVE_RETENTION_SECONDS int64 = 7 * 24 * 3600
+
+// EXPORT_DIRECTORY is where archive export files are written.
+var EXPORT_DIRECTORY = "/data/alm/exports"
+
+// ALARM_ACTION_MAX_ALARMS is the most alarms one AlarmActionRequest may act
+// on. A query matching more is rejected as a whole, to be narrowed down.
+var ALARM_ACTION_MAX_ALARMS = 1000
+
+// SNMP_TRAP_ADDRESS is the UDP address the SNMP trap receiver listens on,
+// e.g. "0.0.0.0:162". Empty, the default, disables the receiver.
+var SNMP_TRAP_ADDRESS = ""
+
+// SNMP_TRAP_COMMUNITIES are the communities accepted on SNMPv1/v2c traps.
+// Empty drops every SNMPv1/v2c trap.
+var SNMP_TRAP_COMMUNITIES []string
+
+// SNMP_TRAP_V3_USERS are the USM users accepted on SNMPv3 traps.
+// Empty drops every SNMPv3 trap.
+var SNMP_TRAP_V3_USERS []SnmpV3User
+
+// SnmpV3User is a USM user of the SNMP trap receiver. Protocols are named as
+// in net-snmp ("MD5", "SHA", "SHA256", ... and "DES", "AES", "AES256", ...);
+// empty means no authentication or no privacy.
+type SnmpV3User struct {
+	UserName       string
+	AuthProtocol   string
+	AuthPassphrase string
+	PrivProtocol   string
+	PrivPassphrase string
+}
+
+// SYSLOG_UDP_ADDRESS and SYSLOG_TCP_ADDRESS are the addresses the syslog
+// receiver listens on, e.g. "0.0.0.0:514". Empty, the default, disables the
+// transport. Syslog is unauthenticated, so bind it to a trusted interface.
+var SYSLOG_UDP_ADDRESS = ""
+var SYSLOG_TCP_ADDRESS = ""
+
+// SYSLOG_TCP_IDLE_SECONDS is how long a syslog TCP connection may stay
+// silent before it is closed.
+var SYSLOG_TCP_IDLE_SECONDS = 300
+
+// SYSLOG_TCP_MAX_CONNECTIONS is the most syslog TCP connections open at once.
+// Further connections are refused until one closes.
+var SYSLOG_TCP_MAX_CONNECTIONS = 256
+
+// SYSLOG_MAX_MESSAGE_SIZE is the largest syslog message accepted, in bytes.
+var SYSLOG_MAX_MESSAGE_SIZE = 64 * 1024
+
+// ALERTMANAGER_NODE_LABELS are the alert labels holding the node of an
+// Alertmanager alert, in order of preference. A host:port value (instance)
+// is reduced to its host.
+var ALERTMANAGER_NODE_LABELS = []string{"node", "host", "instance"}
+
+// ALERTMANAGER_DEFAULT_NODE is the node of Alertmanager alerts without a
+// node label, when the payload names no receiver.
+var ALERTMANAGER_DEFAULT_NODE = "alertmanager"
+
+// WEBHOOK_DEFAULT_NODE is the node of generic webhook events whose mapping
+// has no node_path or whose payload has no value at it.
+var WEBHOOK_DEFAULT_NODE = "webhook"
+
+// HEARTBEAT_DEFINITION_ID is the alarm definition of "heartbeat lost" alarms.
+// It is created on startup if missing; its status and default severity apply.
+var HEARTBEAT_DEFINITION_ID = "heartbeat-lost"
+
+// HEARTBEAT_MISSED_INTERVALS is how many expected intervals may pass without
+// a heartbeat before it is lost, unless a heartbeat monitor sets it.
+var HEARTBEAT_MISSED_INTERVALS int32 = 3
+
+// HEARTBEAT_LEARN_SAMPLES is how many gaps between heartbeats are needed to
+// learn the interval of a node and source without a heartbeat monitor.
+var HEARTBEAT_LEARN_SAMPLES = 3
+
+// HEARTBEAT_HISTORY_SECONDS is how far back heartbeat events are read to
+// rebuild the heartbeat tracker on startup.
+var HEARTBEAT_HISTORY_SECONDS int64 = 24 * 3600
+
+// HEARTBEAT_RETENTION_SECONDS is how long heartbeat events are kept. They
+// never attach to an alarm, so archiving does not remove them; older ones
+// are deleted hourly, but never within HEARTBEAT_HISTORY_SECONDS. Zero keeps
+// them forever.
+var HEARTBEAT_RETENTION_SECONDS int64 = 7 * 24 * 3600
@@ -0,0 +1,37 @@
+package common
+
+import (
+	"fmt"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+// immutableCallback guards an append-only service: PATCH is always
+// rejected and DELETE only passes for internal requests (the archiver).
+// PUT is left to the wrapped callback, which knows which fields, if any,
+// may still change.
+type immutableCallback struct {
+	what     string
+	callback ifs.IServiceCallback
+}
+
+// Immutable wraps the callback of an append-only service of "what"
+// (e.g. "Events") so operators cannot PATCH or DELETE its records.
+func Immutable(what string, callback ifs.IServiceCallback) ifs.IServiceCallback {
+	return &immutableCallback{what: what, callback: callback}
+}
+
+func (c *immutableCallback) Before(element interface{}, action ifs.Action, notification bool, vnic ifs.IVNic) (interface{}, bool, error) {
+	switch action {
+	case ifs.PATCH:
+		return nil, false, fmt.Errorf("%s are immutable and cannot be updated", c.what)
+	case ifs.DELETE:
+		if !IsInternal(element) {
+			return nil, false, fmt.Errorf("%s are immutable and can only be removed by the archiver", c.what)
+		}
+	}
+	return c.callback.Before(element, action, notification, vnic)
+}
+
+func (c *immutableCallback) After(element interface{}, action ifs.Action, notification bool, vnic ifs.IVNic) (interface{}, bool, error) {
+	return c.callback.After(element, action, notification, vnic)
+}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"sync"
+)
+
+// requestMark is how a request element was marked: as an internal call,
+// possibly quiet, or as an operator change made through a service.
+type requestMark struct {
+	internal  bool
+	quiet     bool
+	changedBy string
+	reason    string
+}
+
+// internalRequests holds the request elements of in-flight marked calls,
+// mapped to their requestMark.
+var internalRequests sync.Map
+
+// Internal runs fn with a request element marked as issued by an internal
+// engine. Service callbacks see the same element for an in-process call,
+// while operator requests arrive deserialized into new elements, so the
+// mark cannot be forged from outside. element must be the pointer passed
+// to the service handler: the entity, or the query of a query delete.
+func Internal(element interface{}, fn func()) {
+	mark(element, requestMark{internal: true}, fn)
+}
+
+// Quiet runs fn like Internal, for a record written back as is, such as an
+// alarm restored from the archive. Besides passing the internal checks, a
+// quiet write skips the engine hooks (dedup, maintenance, correlation,
+// notification, escalation, event processing), so it neither touches other
+// records nor notifies again.
+func Quiet(element interface{}, fn func()) {
+	mark(element, requestMark{internal: true, quiet: true}, fn)
+}
+
+// Operator runs fn with a request element marked as an operator change made
+// on behalf of changedBy, such as an alarm action. The request is not
+// internal and passes the same checks as an operator PUT; a state change is
+// recorded as made by changedBy for reason.
+func Operator(element interface{}, changedBy, reason string, fn func()) {
+	mark(element, requestMark{changedBy: changedBy, reason: reason}, fn)
+}
+
+func mark(element interface{}, m requestMark, fn func()) {
+	internalRequests.Store(element, m)
+	defer internalRequests.Delete(element)
+	fn()
+}
+
+func marked(element interface{}) (requestMark, bool) {
+	m, ok := internalRequests.Load(element)
+	if !ok {
+		return requestMark{}, false
+	}
+	return m.(requestMark), true
+}
+
+// IsInternal reports whether a request element is marked by Internal or Quiet.
+func IsInternal(element interface{}) bool {
+	m, _ := marked(element)
+	return m.internal
+}
+
+// IsQuiet reports whether a request element is marked by Quiet.
+func IsQuiet(element interface{}) bool {
+	m, _ := marked(element)
+	return m.quiet
+}
+
+// OperatorChange returns who makes and why for a request element marked by
+// Operator, and false for any other element.
+func OperatorChange(element interface{}) (changedBy, reason string, ok bool) {
+	m, ok := marked(element)
+	if !ok || m.internal {
+		return "", "", false
+	}
+	return m.changedBy, m.reason, true
+}
@@ -0,0 +1,16 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SafeName rejects a name that is joined into a path under a server
+// directory, such as EXPORT_DIRECTORY, if it could escape that directory:
+// it may not contain path separators or "..".
+func SafeName(what, name string) error {
+	if strings.ContainsAny(name, `/\`) || strings.Contains(name, "..") {
+		return fmt.Errorf("%s %q must not contain path separators or ..", what, name)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package common
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// queryable matches the values an L8Query can compare as plain text.
+var queryable = regexp.MustCompile(`^[A-Za-z0-9._:@/-]+$`)
+
+// MatchQuery appends a "field=value" condition to an L8Query where clause.
+// A value the query text cannot carry, such as an empty one or one with
+// spaces, quotes or operators, is left out; callers still compare the field
+// of the results, so the condition only narrows what is loaded.
+func MatchQuery(query, field, value string) string {
+	if !queryable.MatchString(value) {
+		return query
+	}
+	return fmt.Sprintf("%s and %s=%s", query, field, value)
+}
@@ -2,7 +2,9 @@ package common
 
 import (
 	"fmt"
+	"github.com/saichler/l8alarms/go/types/alm"
 	evt "github.com/saichler/l8types/go/types/l8events"
+	"strings"
 	"time"
 )
 
@@ -78,6 +80,52 @@ func Transition(alarm *evt.AlarmRecord, newState evt.AlarmState, changedBy, reas
 	return nil
 }
 
+// Sources of alarm state changes. The source is recorded with the reason of
+// each state_history entry, as "[source] reason".
+const (
+	SourceOperator    = "operator"
+	SourceCorrelation = "correlation"
+	SourceMaintenance = "maintenance"
+	SourceAutoClear   = "auto-clear"
+)
+
+// TransitionAlarm applies Transition to an alm.Alarm, recording the state_history
+// entry and the acknowledged/cleared/suppressed stamps on the alarm itself.
+// Every state change of an alm.Alarm goes through it, so state_history is the
+// complete record of who changed the state, when, why and through which source.
+func TransitionAlarm(alarm *alm.Alarm, newState evt.AlarmState, changedBy, source, reason string) error {
+	if alarm == nil {
+		return fmt.Errorf("alarm is nil")
+	}
+	if source != "" {
+		reason = strings.TrimSpace("[" + source + "] " + reason)
+	}
+
+	record := &evt.AlarmRecord{
+		State:          alarm.State,
+		StateHistory:   alarm.StateHistory,
+		AcknowledgedBy: alarm.AcknowledgedBy,
+		AcknowledgedAt: alarm.AcknowledgedAt,
+		ClearedBy:      alarm.ClearedBy,
+		ClearedAt:      alarm.ClearedAt,
+		IsSuppressed:   alarm.IsSuppressed,
+		SuppressedBy:   alarm.SuppressedBy,
+	}
+	if err := Transition(record, newState, changedBy, reason); err != nil {
+		return err
+	}
+
+	alarm.State = record.State
+	alarm.StateHistory = record.StateHistory
+	alarm.AcknowledgedBy = record.AcknowledgedBy
+	alarm.AcknowledgedAt = record.AcknowledgedAt
+	alarm.ClearedBy = record.ClearedBy
+	alarm.ClearedAt = record.ClearedAt
+	alarm.IsSuppressed = record.IsSuppressed
+	alarm.SuppressedBy = record.SuppressedBy
+	return nil
+}
+
 func Acknowledge(alarm *evt.AlarmRecord, acknowledgedBy string) error {
 	return Transition(alarm, evt.AlarmState_ALARM_STATE_ACKNOWLEDGED, acknowledgedBy, "")
 }
@@ -0,0 +1,108 @@
+package conditions
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strconv"
+	"strings"
+)
+
+var alarmFields = (&alm.Alarm{}).ProtoReflect().Descriptor().Fields()
+
+// AlarmField resolves a field path on an alarm to its string value.
+// A path names any scalar Alarm field in its JSON (camelCase) or proto
+// (snake_case) form, e.g. "linkId", "occurrence_count" or "originalSeverity";
+// enums resolve to their value name (e.g. "SEVERITY_MAJOR").
+// "attributes.<key>" resolves an attribute, and "category" is shorthand for
+// "attributes.category". It returns false if the path is not resolvable.
+func AlarmField(alarm *alm.Alarm, path string) (string, bool) {
+	fd, key, ok := resolvePath(path)
+	if !ok {
+		return "", false
+	}
+	msg := alarm.ProtoReflect()
+	if fd.IsMap() {
+		v := msg.Get(fd).Map().Get(protoreflect.ValueOfString(key).MapKey())
+		if !v.IsValid() {
+			return "", true
+		}
+		return formatValue(fd.MapValue(), v), true
+	}
+	return formatValue(fd, msg.Get(fd)), true
+}
+
+// ValidAlarmField reports whether the path can be resolved by AlarmField.
+func ValidAlarmField(path string) bool {
+	_, _, ok := resolvePath(path)
+	return ok
+}
+
+// resolvePath returns the Alarm field a path refers to and, for map fields, the map key.
+func resolvePath(path string) (protoreflect.FieldDescriptor, string, bool) {
+	name, key, hasKey := strings.Cut(strings.TrimSpace(path), ".")
+	if !hasKey && name == "category" {
+		name, key, hasKey = "attributes", "category", true
+	}
+
+	fd := alarmFields.ByJSONName(name)
+	if fd == nil {
+		fd = alarmFields.ByName(protoreflect.Name(name))
+	}
+	if fd == nil {
+		return nil, "", false
+	}
+
+	if fd.IsMap() {
+		if !hasKey || key == "" || fd.MapKey().Kind() != protoreflect.StringKind {
+			return nil, "", false
+		}
+		return fd, key, true
+	}
+	if hasKey || fd.IsList() || fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		return nil, "", false
+	}
+	return fd, "", true
+}
+
+func formatValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.Itoa(int(v.Enum()))
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.Bool())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case protoreflect.BytesKind:
+		return string(v.Bytes())
+	}
+	return v.String()
+}
+
+// Match resolves the condition's field on the alarm and evaluates it.
+// Conditions on fields that cannot be resolved never match.
+func Match(alarm *alm.Alarm, cond *alm.CorrelationCondition) bool {
+	fieldVal, ok := AlarmField(alarm, cond.Field)
+	if !ok {
+		return false
+	}
+	return Evaluate(fieldVal, cond)
+}
+
+// MatchAll reports whether the alarm satisfies every condition.
+func MatchAll(alarm *alm.Alarm, conds []*alm.CorrelationCondition) bool {
+	for _, cond := range conds {
+		if !Match(alarm, cond) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+package conditions
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Evaluate applies the condition's operator to a resolved field value.
+// GREATER_THAN and LESS_THAN compare severity ordinals (or plain numbers),
+// IN takes a comma-separated list of values. Conditions with an unknown
+// operator or a value that cannot be interpreted never match.
+func Evaluate(fieldVal string, cond *alm.CorrelationCondition) bool {
+	switch cond.Operator {
+	case alm.ConditionOperator_CONDITION_OPERATOR_EQUALS:
+		return fieldVal == cond.Value
+	case alm.ConditionOperator_CONDITION_OPERATOR_NOT_EQUALS:
+		return fieldVal != cond.Value
+	case alm.ConditionOperator_CONDITION_OPERATOR_CONTAINS:
+		return strings.Contains(fieldVal, cond.Value)
+	case alm.ConditionOperator_CONDITION_OPERATOR_REGEX:
+		re, err := regexp.Compile(cond.Value)
+		if err != nil {
+			return false
+		}
+		return re.MatchString(fieldVal)
+	case alm.ConditionOperator_CONDITION_OPERATOR_GREATER_THAN:
+		left, lok := ordinal(fieldVal)
+		right, rok := ordinal(cond.Value)
+		return lok && rok && left > right
+	case alm.ConditionOperator_CONDITION_OPERATOR_LESS_THAN:
+		left, lok := ordinal(fieldVal)
+		right, rok := ordinal(cond.Value)
+		return lok && rok && left < right
+	case alm.ConditionOperator_CONDITION_OPERATOR_IN:
+		for _, v := range listValues(cond.Value) {
+			if fieldVal == v {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// Validate checks that a condition names a resolvable alarm field, uses a
+// known operator and has a value the operator can interpret.
+func Validate(cond *alm.CorrelationCondition) error {
+	if cond == nil {
+		return fmt.Errorf("condition is empty")
+	}
+	if strings.TrimSpace(cond.Field) == "" {
+		return fmt.Errorf("condition %s: Field is required", cond.ConditionId)
+	}
+	if !ValidAlarmField(cond.Field) {
+		return fmt.Errorf("condition %s: unknown alarm field %q", cond.ConditionId, cond.Field)
+	}
+
+	switch cond.Operator {
+	case alm.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+		alm.ConditionOperator_CONDITION_OPERATOR_NOT_EQUALS:
+		return nil
+	case alm.ConditionOperator_CONDITION_OPERATOR_CONTAINS:
+		if cond.Value == "" {
+			return fmt.Errorf("condition %s: CONTAINS requires a value", cond.ConditionId)
+		}
+	case alm.ConditionOperator_CONDITION_OPERATOR_REGEX:
+		if _, err := regexp.Compile(cond.Value); err != nil {
+			return fmt.Errorf("condition %s: invalid regex %q: %v", cond.ConditionId, cond.Value, err)
+		}
+	case alm.ConditionOperator_CONDITION_OPERATOR_GREATER_THAN,
+		alm.ConditionOperator_CONDITION_OPERATOR_LESS_THAN:
+		if _, ok := ordinal(cond.Value); !ok {
+			return fmt.Errorf("condition %s: %s requires a severity or a number, got %q",
+				cond.ConditionId, cond.Operator.String(), cond.Value)
+		}
+	case alm.ConditionOperator_CONDITION_OPERATOR_IN:
+		if len(listValues(cond.Value)) == 0 {
+			return fmt.Errorf("condition %s: IN requires a comma-separated list of values", cond.ConditionId)
+		}
+	default:
+		return fmt.Errorf("condition %s: invalid operator %d", cond.ConditionId, int32(cond.Operator))
+	}
+	return nil
+}
+
+// ValidateAll validates every condition, returning the first error.
+func ValidateAll(conds []*alm.CorrelationCondition) error {
+	for _, cond := range conds {
+		if err := Validate(cond); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ordinal interprets a value as a number or a severity, e.g. "4", "MAJOR"
+// or "SEVERITY_MAJOR", and returns its ordinal.
+func ordinal(value string) (int64, bool) {
+	value = strings.TrimSpace(value)
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return n, true
+	}
+	name := strings.ToUpper(value)
+	if v, ok := l8events.Severity_value[name]; ok {
+		return int64(v), true
+	}
+	if v, ok := l8events.Severity_value["SEVERITY_"+name]; ok {
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// listValues splits a comma-separated list, trimming blanks and dropping empty items.
+func listValues(value string) []string {
+	var result []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
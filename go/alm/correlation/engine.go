@@ -1,6 +1,8 @@
 package correlation
 
 import (
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/alm/conditions"
 	"github.com/saichler/l8alarms/go/types/alm"
 	l8events "github.com/saichler/l8types/go/types/l8events"
 	"github.com/saichler/l8types/go/ifs"
@@ -66,7 +68,7 @@ func (e *Engine) Correlate(alarm *alm.Alarm, rules []*alm.CorrelationRule, ctx *
 		if rule.Status != alm.CorrelationRuleStatus_CORRELATION_RULE_STATUS_ACTIVE {
 			continue
 		}
-		if !matchesConditions(alarm, rule.Conditions) {
+		if !conditions.MatchAll(alarm, rule.Conditions) {
 			continue
 		}
 
@@ -92,15 +94,16 @@ func (e *Engine) Correlate(alarm *alm.Alarm, rules []*alm.CorrelationRule, ctx *
 		rootCause.SymptomCount++
 
 		// Apply auto-suppression
-		if rule.AutoSuppressSymptoms {
-			alarm.State = l8events.AlarmState_ALARM_STATE_SUPPRESSED
+		if rule.AutoSuppressSymptoms && transition(alarm, l8events.AlarmState_ALARM_STATE_SUPPRESSED, rule,
+			"suppressed as a symptom of "+rootCause.AlarmId) {
 			alarm.IsSuppressed = true
 			alarm.SuppressedBy = rootCause.AlarmId
 		}
 
 		// Apply auto-acknowledge if root is acknowledged
 		if rule.AutoAcknowledgeSymptoms && rootCause.State == l8events.AlarmState_ALARM_STATE_ACKNOWLEDGED {
-			alarm.State = l8events.AlarmState_ALARM_STATE_ACKNOWLEDGED
+			transition(alarm, l8events.AlarmState_ALARM_STATE_ACKNOWLEDGED, rule,
+				"acknowledged with root cause "+rootCause.AlarmId)
 		}
 
 		return rootCause
@@ -108,67 +111,15 @@ func (e *Engine) Correlate(alarm *alm.Alarm, rules []*alm.CorrelationRule, ctx *
 	return nil
 }
 
-// matchesConditions checks if an alarm satisfies all conditions of a rule.
-func matchesConditions(alarm *alm.Alarm, conditions []*alm.CorrelationCondition) bool {
-	for _, cond := range conditions {
-		if !evaluateCondition(alarm, cond) {
-			return false
-		}
-	}
-	return true
-}
-
-// evaluateCondition evaluates a single condition against an alarm.
-func evaluateCondition(alarm *alm.Alarm, cond *alm.CorrelationCondition) bool {
-	fieldVal := getAlarmField(alarm, cond.Field)
-
-	switch cond.Operator {
-	case alm.ConditionOperator_CONDITION_OPERATOR_EQUALS:
-		return fieldVal == cond.Value
-	case alm.ConditionOperator_CONDITION_OPERATOR_NOT_EQUALS:
-		return fieldVal != cond.Value
-	case alm.ConditionOperator_CONDITION_OPERATOR_CONTAINS:
-		return containsStr(fieldVal, cond.Value)
-	default:
-		return true
+// transition moves a symptom alarm to a new state on behalf of a rule and
+// reports whether it moved. An alarm posted without a state is ACTIVE.
+// Alarms already in that state, or that cannot move to it, are left as is.
+func transition(alarm *alm.Alarm, state l8events.AlarmState, rule *alm.CorrelationRule, reason string) bool {
+	if alarm.State == l8events.AlarmState_ALARM_STATE_UNSPECIFIED {
+		alarm.State = l8events.AlarmState_ALARM_STATE_ACTIVE
 	}
-}
-
-// getAlarmField returns the string value of a named alarm field.
-func getAlarmField(alarm *alm.Alarm, field string) string {
-	switch field {
-	case "severity":
-		return alarm.Severity.String()
-	case "state":
-		return alarm.State.String()
-	case "name":
-		return alarm.Name
-	case "nodeId":
-		return alarm.NodeId
-	case "nodeName":
-		return alarm.NodeName
-	case "location":
-		return alarm.Location
-	case "definitionId":
-		return alarm.DefinitionId
-	case "category":
-		if v, ok := alarm.Attributes["category"]; ok {
-			return v
-		}
-	}
-	return ""
-}
-
-func containsStr(s, substr string) bool {
-	return len(s) >= len(substr) && (s == substr || len(substr) == 0 ||
-		findSubstring(s, substr))
-}
-
-func findSubstring(s, sub string) bool {
-	for i := 0; i <= len(s)-len(sub); i++ {
-		if s[i:i+len(sub)] == sub {
-			return true
-		}
+	if !common.ValidTransition(alarm.State, state) {
+		return false
 	}
-	return false
+	return common.TransitionAlarm(alarm, state, "correlation:"+rule.RuleId, common.SourceCorrelation, reason) == nil
 }
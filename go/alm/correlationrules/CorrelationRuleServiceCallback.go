@@ -1,6 +1,7 @@
 package correlationrules
 
 import (
+	"github.com/saichler/l8alarms/go/alm/conditions"
 	"github.com/saichler/l8alarms/go/types/alm"
 	"github.com/saichler/l8common/go/common"
 	"github.com/saichler/l8types/go/ifs"
@@ -12,5 +13,15 @@ func newCorrelationRuleServiceCallback(vnic ifs.IVNic) ifs.IServiceCallback {
 		Require(func(e interface{}) string { return e.(*alm.CorrelationRule).Name }, "Name").
 		Enum(func(e interface{}) int32 { return int32(e.(*alm.CorrelationRule).RuleType) }, alm.CorrelationRuleType_name, "RuleType").
 		Enum(func(e interface{}) int32 { return int32(e.(*alm.CorrelationRule).Status) }, alm.CorrelationRuleStatus_name, "Status").
+		BeforeAction(validateConditions).
 		Build()
 }
+
+// validateConditions rejects rules with malformed conditions, so that a
+// condition cannot silently match every alarm.
+func validateConditions(rule *alm.CorrelationRule, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST && action != ifs.PUT && action != ifs.PATCH {
+		return nil
+	}
+	return conditions.ValidateAll(rule.Conditions)
+}
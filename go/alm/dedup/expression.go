@@ -0,0 +1,104 @@
+package dedup
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultExpression is used when dedup is enabled on a definition without a
+// dedup_key_expression: one alarm per node and source identifier.
+const DefaultExpression = "${nodeId}:${sourceIdentifier}"
+
+const attributesPrefix = "attributes."
+
+// fields are the top-level names a ${...} placeholder may reference.
+// Any other value is referenced as ${attributes.<key>}.
+var fields = map[string]bool{
+	"definitionId":     true,
+	"nodeId":           true,
+	"nodeName":         true,
+	"sourceIdentifier": true,
+	"category":         true,
+	"subcategory":      true,
+}
+
+// Resolver returns the value of a placeholder field, e.g. "nodeId" or "attributes.ifIndex".
+type Resolver func(field string) string
+
+// Validate checks that the expression has a ${...} placeholder, that every
+// placeholder is closed and references a known field or an attribute. An
+// expression without placeholders would give every alarm the same key.
+// The legacy form, field names joined by "+", is accepted, and an empty
+// expression stands for DefaultExpression.
+func Validate(expression string) error {
+	if expression == "" {
+		return nil
+	}
+	_, err := evaluate(expression, func(string) string { return "" })
+	return err
+}
+
+// Evaluate substitutes each ${field} placeholder in the expression with its
+// resolved value. Text outside placeholders is kept as-is. A legacy
+// expression such as "nodeId+definitionId" is read as "${nodeId}:${definitionId}".
+// Malformed expressions fall back to DefaultExpression.
+func Evaluate(expression string, resolve Resolver) string {
+	result, err := evaluate(expression, resolve)
+	if err != nil {
+		result, _ = evaluate(DefaultExpression, resolve)
+	}
+	return result
+}
+
+// legacy translates an expression of field names joined by "+", the syntax
+// before ${...} placeholders, to placeholders joined by ":". It returns false
+// for any other expression.
+func legacy(expression string) (string, bool) {
+	if strings.Contains(expression, "${") {
+		return "", false
+	}
+	parts := strings.Split(expression, "+")
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if !validField(part) {
+			return "", false
+		}
+		parts[i] = "${" + part + "}"
+	}
+	return strings.Join(parts, ":"), true
+}
+
+func evaluate(expression string, resolve Resolver) (string, error) {
+	if translated, ok := legacy(expression); ok {
+		expression = translated
+	} else if !strings.Contains(expression, "${") {
+		return "", fmt.Errorf("dedup key expression %q has no ${field} placeholder", expression)
+	}
+	var sb strings.Builder
+	rest := expression
+	for {
+		start := strings.Index(rest, "${")
+		if start < 0 {
+			sb.WriteString(rest)
+			return sb.String(), nil
+		}
+		sb.WriteString(rest[:start])
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated placeholder in dedup key expression %q", expression)
+		}
+		field := strings.TrimSpace(rest[start+2 : start+end])
+		if !validField(field) {
+			return "", fmt.Errorf("unknown field %q in dedup key expression %q", field, expression)
+		}
+		sb.WriteString(resolve(field))
+		rest = rest[start+end+1:]
+	}
+}
+
+func validField(field string) bool {
+	if strings.HasPrefix(field, attributesPrefix) {
+		return len(field) > len(attributesPrefix)
+	}
+	return fields[field]
+}
@@ -0,0 +1,81 @@
+package dedup
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"strings"
+)
+
+// EventKey computes the dedup key of an event matched to the definition.
+// It returns "" if dedup is disabled on the definition.
+func EventKey(def *alm.AlarmDefinition, event *alm.Event) string {
+	if !def.DedupEnabled {
+		return ""
+	}
+	return Evaluate(expressionOf(def), func(field string) string {
+		switch field {
+		case "definitionId":
+			return def.DefinitionId
+		case "nodeId":
+			return event.NodeId
+		case "nodeName":
+			return event.NodeName
+		case "sourceIdentifier":
+			return event.SourceIdentifier
+		case "category":
+			return event.Category
+		case "subcategory":
+			return event.Subcategory
+		}
+		key := strings.TrimPrefix(field, attributesPrefix)
+		for _, attr := range event.Attributes {
+			if attr.Key == key {
+				return attr.Value
+			}
+		}
+		return ""
+	})
+}
+
+// AlarmKey computes the dedup key of an alarm raised from the definition.
+// Alarms raised from events carry the event's category and subcategory as
+// attributes, so an alarm and its originating event produce the same key.
+// It returns "" if dedup is disabled on the definition.
+func AlarmKey(def *alm.AlarmDefinition, alarm *alm.Alarm) string {
+	if !def.DedupEnabled {
+		return ""
+	}
+	return Evaluate(expressionOf(def), func(field string) string {
+		switch field {
+		case "definitionId":
+			return def.DefinitionId
+		case "nodeId":
+			return alarm.NodeId
+		case "nodeName":
+			return alarm.NodeName
+		case "sourceIdentifier":
+			return alarm.SourceIdentifier
+		}
+		return alarm.Attributes[strings.TrimPrefix(field, attributesPrefix)]
+	})
+}
+
+// RecordOccurrence folds a repeat occurrence into an open alarm: it bumps
+// occurrence_count and last_occurrence and, if escalate is set, raises the
+// severity. Severity is never lowered by a repeat occurrence.
+func RecordOccurrence(alarm *alm.Alarm, occurredAt int64, severity l8events.Severity, escalate bool) {
+	alarm.OccurrenceCount++
+	if occurredAt > alarm.LastOccurrence {
+		alarm.LastOccurrence = occurredAt
+	}
+	if escalate && severity > alarm.Severity {
+		alarm.Severity = severity
+	}
+}
+
+func expressionOf(def *alm.AlarmDefinition) string {
+	if def.DedupKeyExpression == "" {
+		return DefaultExpression
+	}
+	return def.DedupKeyExpression
+}
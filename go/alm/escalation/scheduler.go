@@ -2,7 +2,9 @@ package escalation
 
 import (
 	"fmt"
+	"github.com/saichler/l8alarms/go/alm/conditions"
 	"github.com/saichler/l8alarms/go/alm/escalationpolicies"
+	"github.com/saichler/l8alarms/go/alm/escalationstates"
 	"github.com/saichler/l8alarms/go/alm/notification"
 	"github.com/saichler/l8alarms/go/types/alm"
 	"github.com/saichler/l8common/go/common"
@@ -15,30 +17,39 @@ import (
 	"time"
 )
 
-// Scheduler manages escalation timers for unacknowledged alarms.
+// Scheduler manages escalation timers for unacknowledged alarms. Every
+// matching policy escalates an alarm independently, tracked per (alarm, policy).
+// Escalation progress is persisted through the EscalationState service so
+// that timers can be rebuilt by Resume after a restart.
 type Scheduler struct {
-	// active tracks running escalation timers by alarm ID
+	// active tracks running escalation timers by state ID (alarm + policy)
 	active map[string]*escalationState
 	mtx    sync.Mutex
+	lookup AlarmLookup
 }
 
 type escalationState struct {
-	alarmId   string
-	policyId  string
-	stepIndex int
-	timer     *time.Timer
-	cancel    chan struct{}
+	record *alm.EscalationState
+	timer  *time.Timer
+	cancel chan struct{}
 }
 
-// NewScheduler creates a new escalation scheduler.
-func NewScheduler() *Scheduler {
+// AlarmLookup returns the current alarm by ID, or nil if it no longer exists.
+type AlarmLookup func(alarmId string, vnic ifs.IVNic) (*alm.Alarm, error)
+
+// NewScheduler creates a new escalation scheduler. The lookup re-reads an
+// alarm before each step fires and when escalations are resumed.
+func NewScheduler(lookup AlarmLookup) *Scheduler {
 	return &Scheduler{
 		active: make(map[string]*escalationState),
+		lookup: lookup,
 	}
 }
 
-// Schedule evaluates escalation policies for a new alarm and starts timers
-// for matching policies.
+// Schedule evaluates escalation policies for an ACTIVE alarm and starts an
+// escalation for every matching policy that is not already escalating it.
+// Escalations are removed when the alarm leaves ACTIVE, so an alarm that
+// re-enters ACTIVE escalates again from the first step.
 func (s *Scheduler) Schedule(alarm *alm.Alarm, vnic ifs.IVNic) {
 	// Only schedule for active alarms
 	if alarm.State != l8events.AlarmState_ALARM_STATE_ACTIVE {
@@ -55,88 +66,218 @@ func (s *Scheduler) Schedule(alarm *alm.Alarm, vnic ifs.IVNic) {
 		return
 	}
 
+	existing, err := escalationstates.ForAlarm(alarm.AlarmId, vnic)
+	if err != nil {
+		fmt.Printf("[escalation] failed to query escalation states of alarm %s: %v\n", alarm.AlarmId, err)
+		return
+	}
+	escalating := make(map[string]bool, len(existing))
+	for _, record := range existing {
+		escalating[record.PolicyId] = true
+	}
+
 	for _, raw := range policiesRaw {
 		policy := raw.(*alm.EscalationPolicy)
-		if !matchesEscalationPolicy(alarm, policy) {
+		if escalating[policy.PolicyId] || !matchesEscalationPolicy(alarm, policy) {
 			continue
 		}
 		if len(policy.Steps) == 0 {
 			continue
 		}
 
-		// Sort steps by order
-		steps := make([]*l8notify.EscalationStep, len(policy.Steps))
-		copy(steps, policy.Steps)
-		sort.Slice(steps, func(i, j int) bool {
-			return steps[i].StepOrder < steps[j].StepOrder
-		})
+		steps := sortedSteps(policy)
+		now := time.Now().Unix()
+		s.startEscalation(policy, steps, &alm.EscalationState{
+			StateId:       escalationstates.StateId(alarm.AlarmId, policy.PolicyId),
+			AlarmId:       alarm.AlarmId,
+			PolicyId:      policy.PolicyId,
+			StepIndex:     0,
+			NextFireAt:    now + stepDelay(steps[0]),
+			LastFiredStep: -1,
+			StartedAt:     now,
+		}, vnic)
+	}
+}
 
-		s.startEscalation(alarm, policy, steps, 0, vnic)
-		break // Use the first matching policy
+// Resume rebuilds escalation timers from the persisted escalation states,
+// e.g. after a restart. Steps that became due while the service was down
+// fire immediately; each step fires at most once, since progress is
+// persisted before a step's notification is sent. Escalations whose alarm is
+// no longer active, or whose policy is gone or inactive, are dropped;
+// completed escalations are kept until the alarm leaves ACTIVE.
+func (s *Scheduler) Resume(vnic ifs.IVNic) {
+	statesRaw, err := common.GetEntitiesByQuery(
+		escalationstates.ServiceName, escalationstates.ServiceArea,
+		"select * from EscalationState", vnic,
+	)
+	if err != nil {
+		fmt.Printf("[escalation] failed to query escalation states: %v\n", err)
+		return
+	}
+
+	for _, raw := range statesRaw {
+		record := raw.(*alm.EscalationState)
+		policy, steps := s.resumable(record, vnic)
+		if policy == nil {
+			if err := escalationstates.Remove(record.StateId, vnic); err != nil {
+				fmt.Printf("[escalation] failed to remove state %s: %v\n", record.StateId, err)
+			}
+			continue
+		}
+		if int(record.StepIndex) >= len(steps) {
+			continue
+		}
+		s.startEscalation(policy, steps, record, vnic)
 	}
 }
 
-// Cancel stops any running escalation for the given alarm.
-func (s *Scheduler) Cancel(alarmId string) {
-	s.mtx.Lock()
-	defer s.mtx.Unlock()
+// resumable loads the policy of a persisted escalation state. It returns a
+// nil policy if the escalation should not be resumed.
+func (s *Scheduler) resumable(record *alm.EscalationState, vnic ifs.IVNic) (*alm.EscalationPolicy, []*l8notify.EscalationStep) {
+	alarm, err := s.lookup(record.AlarmId, vnic)
+	if err != nil || alarm == nil || alarm.State != l8events.AlarmState_ALARM_STATE_ACTIVE {
+		return nil, nil
+	}
+	policy, err := escalationpolicies.EscalationPolicy(record.PolicyId, vnic)
+	if err != nil || policy == nil || policy.Status != alm.AlmPolicyStatus_ALM_POLICY_STATUS_ACTIVE {
+		return nil, nil
+	}
+	return policy, sortedSteps(policy)
+}
 
-	if state, ok := s.active[alarmId]; ok {
+// Cancel stops every running escalation for the given alarm and removes
+// its persisted states, including completed ones.
+func (s *Scheduler) Cancel(alarmId string, vnic ifs.IVNic) {
+	s.mtx.Lock()
+	for stateId, state := range s.active {
+		if state.record.AlarmId != alarmId {
+			continue
+		}
 		close(state.cancel)
 		state.timer.Stop()
-		delete(s.active, alarmId)
+		delete(s.active, stateId)
+	}
+	s.mtx.Unlock()
+
+	records, err := escalationstates.ForAlarm(alarmId, vnic)
+	if err != nil {
+		fmt.Printf("[escalation] failed to query escalation states of alarm %s: %v\n", alarmId, err)
+		return
+	}
+	for _, record := range records {
+		if err := escalationstates.Remove(record.StateId, vnic); err != nil {
+			fmt.Printf("[escalation] failed to remove state %s: %v\n", record.StateId, err)
+		}
 	}
 }
 
-// HandleStateChange cancels escalation when alarm is acknowledged or cleared.
-func (s *Scheduler) HandleStateChange(alarm *alm.Alarm) {
+// HandleStateChange cancels escalation when the alarm is acknowledged,
+// cleared or suppressed, and (re)starts it when the alarm is ACTIVE, e.g.
+// after being re-opened or released from a maintenance window.
+func (s *Scheduler) HandleStateChange(alarm *alm.Alarm, vnic ifs.IVNic) {
 	switch alarm.State {
 	case l8events.AlarmState_ALARM_STATE_ACKNOWLEDGED,
 		l8events.AlarmState_ALARM_STATE_CLEARED,
 		l8events.AlarmState_ALARM_STATE_SUPPRESSED:
-		s.Cancel(alarm.AlarmId)
+		s.Cancel(alarm.AlarmId, vnic)
+	case l8events.AlarmState_ALARM_STATE_ACTIVE:
+		s.Schedule(alarm, vnic)
 	}
 }
 
-func (s *Scheduler) startEscalation(alarm *alm.Alarm, policy *alm.EscalationPolicy, steps []*l8notify.EscalationStep, stepIdx int, vnic ifs.IVNic) {
-	if stepIdx >= len(steps) {
-		return
+// startEscalation persists the escalation state and arms a timer for the
+// state's next step at next_fire_at. Overdue steps fire immediately.
+func (s *Scheduler) startEscalation(policy *alm.EscalationPolicy, steps []*l8notify.EscalationStep, record *alm.EscalationState, vnic ifs.IVNic) {
+	record.UpdatedAt = time.Now().Unix()
+	if err := escalationstates.Save(record, vnic); err != nil {
+		fmt.Printf("[escalation] failed to persist state %s: %v\n", record.StateId, err)
 	}
 
-	step := steps[stepIdx]
-	delay := time.Duration(step.DelayMinutes) * time.Minute
+	delay := time.Until(time.Unix(record.NextFireAt, 0))
+	if delay < 0 {
+		delay = 0
+	}
 
-	cancel := make(chan struct{})
-	timer := time.NewTimer(delay)
+	state := &escalationState{
+		record: record,
+		timer:  time.NewTimer(delay),
+		cancel: make(chan struct{}),
+	}
 
 	s.mtx.Lock()
-	// Cancel any existing escalation for this alarm
-	if existing, ok := s.active[alarm.AlarmId]; ok {
+	// Cancel any existing escalation for this alarm and policy
+	if existing, ok := s.active[record.StateId]; ok {
 		close(existing.cancel)
 		existing.timer.Stop()
 	}
-	s.active[alarm.AlarmId] = &escalationState{
-		alarmId:   alarm.AlarmId,
-		policyId:  policy.PolicyId,
-		stepIndex: stepIdx,
-		timer:     timer,
-		cancel:    cancel,
-	}
+	s.active[record.StateId] = state
 	s.mtx.Unlock()
 
 	go func() {
 		select {
-		case <-timer.C:
-			s.fireStep(alarm, policy, steps, stepIdx, vnic)
-		case <-cancel:
-			timer.Stop()
+		case <-state.timer.C:
+			s.fireStep(policy, steps, state, vnic)
+		case <-state.cancel:
+			state.timer.Stop()
 		}
 	}()
 }
 
-func (s *Scheduler) fireStep(alarm *alm.Alarm, policy *alm.EscalationPolicy, steps []*l8notify.EscalationStep, stepIdx int, vnic ifs.IVNic) {
+// fireStep sends the state's next step. The escalation may have been
+// cancelled, replaced or its alarm acknowledged as the timer fired, so the
+// alarm and the persisted state are re-read under the scheduler lock first.
+func (s *Scheduler) fireStep(policy *alm.EscalationPolicy, steps []*l8notify.EscalationStep, state *escalationState, vnic ifs.IVNic) {
+	record := state.record
+	s.mtx.Lock()
+	if current, ok := s.active[record.StateId]; !ok || current != state {
+		s.mtx.Unlock()
+		return
+	}
+	delete(s.active, record.StateId)
+	alarm, err := s.lookup(record.AlarmId, vnic)
+	var stored *alm.EscalationState
+	if err == nil {
+		stored, err = escalationstates.EscalationState(record.StateId, vnic)
+	}
+	s.mtx.Unlock()
+
+	if err != nil {
+		fmt.Printf("[escalation] failed to re-read state %s: %v\n", record.StateId, err)
+		return
+	}
+	if stored == nil {
+		return // cancelled
+	}
+	if alarm == nil || alarm.State != l8events.AlarmState_ALARM_STATE_ACTIVE {
+		// The alarm left ACTIVE as the step fired; drop the state so the
+		// alarm escalates again if it is re-opened
+		if err := escalationstates.Remove(record.StateId, vnic); err != nil {
+			fmt.Printf("[escalation] failed to remove state %s: %v\n", record.StateId, err)
+		}
+		return
+	}
+
+	stepIdx := int(record.StepIndex)
 	step := steps[stepIdx]
 
+	// Record the step as fired before sending, so a restart never repeats it.
+	// A completed escalation keeps its state so it is not restarted while the
+	// alarm stays ACTIVE.
+	now := time.Now().Unix()
+	record.LastFiredStep = int32(stepIdx)
+	record.LastFiredAt = now
+	record.StepIndex = int32(stepIdx + 1)
+	if stepIdx+1 < len(steps) {
+		record.NextFireAt = now + stepDelay(steps[stepIdx+1])
+		s.startEscalation(policy, steps, record, vnic)
+	} else {
+		record.NextFireAt = 0
+		record.UpdatedAt = now
+		if err := escalationstates.Save(record, vnic); err != nil {
+			fmt.Printf("[escalation] failed to persist state %s: %v\n", record.StateId, err)
+		}
+	}
+
 	// Render message using l8notify template engine
 	vars := map[string]string{
 		"alarm.id":       alarm.AlarmId,
@@ -157,16 +298,21 @@ func (s *Scheduler) fireStep(alarm *alm.Alarm, policy *alm.EscalationPolicy, ste
 		fmt.Printf("[escalation] step %d failed for alarm %s: %v\n",
 			step.StepOrder, alarm.AlarmId, err)
 	}
+}
 
-	// Clean up current state
-	s.mtx.Lock()
-	delete(s.active, alarm.AlarmId)
-	s.mtx.Unlock()
+// sortedSteps returns the policy's steps sorted by step_order.
+func sortedSteps(policy *alm.EscalationPolicy) []*l8notify.EscalationStep {
+	steps := make([]*l8notify.EscalationStep, len(policy.Steps))
+	copy(steps, policy.Steps)
+	sort.Slice(steps, func(i, j int) bool {
+		return steps[i].StepOrder < steps[j].StepOrder
+	})
+	return steps
+}
 
-	// Schedule next step if available
-	if stepIdx+1 < len(steps) {
-		s.startEscalation(alarm, policy, steps, stepIdx+1, vnic)
-	}
+// stepDelay returns the step's delay after the previous step, in seconds.
+func stepDelay(step *l8notify.EscalationStep) int64 {
+	return int64(step.DelayMinutes) * 60
 }
 
 // matchesEscalationPolicy checks if an alarm matches an escalation policy's scope.
@@ -188,5 +334,5 @@ func matchesEscalationPolicy(alarm *alm.Alarm, policy *alm.EscalationPolicy) boo
 		}
 	}
 
-	return true
+	return conditions.MatchAll(alarm, policy.Conditions)
 }
@@ -1,6 +1,7 @@
 package escalationpolicies
 
 import (
+	"github.com/saichler/l8alarms/go/alm/conditions"
 	"github.com/saichler/l8alarms/go/types/alm"
 	"github.com/saichler/l8common/go/common"
 	"github.com/saichler/l8types/go/ifs"
@@ -11,5 +12,14 @@ func newEscalationPolicyServiceCallback(vnic ifs.IVNic) ifs.IServiceCallback {
 		Require(func(e interface{}) string { return e.(*alm.EscalationPolicy).PolicyId }, "PolicyId").
 		Require(func(e interface{}) string { return e.(*alm.EscalationPolicy).Name }, "Name").
 		Enum(func(e interface{}) int32 { return int32(e.(*alm.EscalationPolicy).Status) }, alm.AlmPolicyStatus_name, "Status").
+		BeforeAction(validateConditions).
 		Build()
 }
+
+// validateConditions rejects policies with malformed alarm field conditions.
+func validateConditions(policy *alm.EscalationPolicy, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST && action != ifs.PUT && action != ifs.PATCH {
+		return nil
+	}
+	return conditions.ValidateAll(policy.Conditions)
+}
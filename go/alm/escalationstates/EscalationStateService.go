@@ -0,0 +1,87 @@
+package escalationstates
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+const (
+	ServiceName = "EscState"
+	ServiceArea = byte(10)
+)
+
+func Activate(creds, dbname string, vnic ifs.IVNic) {
+	common.ActivateService(common.ServiceConfig{
+		ServiceName: ServiceName, ServiceArea: ServiceArea,
+		PrimaryKey: "StateId", Callback: newEscalationStateServiceCallback(vnic),
+	}, &alm.EscalationState{}, &alm.EscalationStateList{}, creds, dbname, vnic)
+}
+
+func EscalationStates(vnic ifs.IVNic) (ifs.IServiceHandler, bool) {
+	return common.ServiceHandler(ServiceName, ServiceArea, vnic)
+}
+
+func EscalationState(id string, vnic ifs.IVNic) (*alm.EscalationState, error) {
+	result, err := common.GetEntity(ServiceName, ServiceArea, &alm.EscalationState{StateId: id}, vnic)
+	if err != nil || result == nil {
+		return nil, err
+	}
+	return result.(*alm.EscalationState), nil
+}
+
+// StateId is the primary key of the escalation state of an alarm under a policy.
+func StateId(alarmId, policyId string) string {
+	return alarmId + ":" + policyId
+}
+
+// ForAlarm returns the persisted escalation states of an alarm, one per policy.
+func ForAlarm(alarmId string, vnic ifs.IVNic) ([]*alm.EscalationState, error) {
+	statesRaw, err := common.GetEntitiesByQuery(ServiceName, ServiceArea,
+		fmt.Sprintf("select * from EscalationState where AlarmId=%s", alarmId), vnic)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*alm.EscalationState, 0, len(statesRaw))
+	for _, raw := range statesRaw {
+		result = append(result, raw.(*alm.EscalationState))
+	}
+	return result, nil
+}
+
+// Save persists the escalation state, creating it on first save.
+func Save(state *alm.EscalationState, vnic ifs.IVNic) error {
+	existing, err := EscalationState(state.StateId, vnic)
+	if err == nil && existing != nil {
+		return common.PutEntity(ServiceName, ServiceArea, state, vnic)
+	}
+	handler, ok := EscalationStates(vnic)
+	if !ok {
+		return fmt.Errorf("EscalationState service not available")
+	}
+	resp := handler.Post(object.New(nil, state), vnic)
+	if resp.Error() != nil {
+		return resp.Error()
+	}
+	return nil
+}
+
+// Remove deletes the persisted escalation state, if any.
+func Remove(stateId string, vnic ifs.IVNic) error {
+	handler, ok := EscalationStates(vnic)
+	if !ok {
+		return fmt.Errorf("EscalationState service not available")
+	}
+	query := fmt.Sprintf("select * from EscalationState where StateId=%s", stateId)
+	elems, err := object.NewQuery(query, vnic.Resources())
+	if err != nil {
+		return err
+	}
+	resp := handler.Delete(elems, vnic)
+	if resp.Error() != nil {
+		return resp.Error()
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package escalationstates
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+func newEscalationStateServiceCallback(vnic ifs.IVNic) ifs.IServiceCallback {
+	return common.NewValidation(&alm.EscalationState{}, vnic).
+		Require(func(e interface{}) string { return e.(*alm.EscalationState).StateId }, "StateId").
+		Require(func(e interface{}) string { return e.(*alm.EscalationState).AlarmId }, "AlarmId").
+		Require(func(e interface{}) string { return e.(*alm.EscalationState).PolicyId }, "PolicyId").
+		Build()
+}
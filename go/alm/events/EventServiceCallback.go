@@ -2,24 +2,67 @@ package events
 
 import (
 	"errors"
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/common"
 	"github.com/saichler/l8alarms/go/types/alm"
-	"github.com/saichler/l8common/go/common"
+	l8common "github.com/saichler/l8common/go/common"
 	"github.com/saichler/l8types/go/ifs"
 )
 
-func rejectPut(_ *alm.Event, action ifs.Action, _ ifs.IVNic) error {
-	if action == ifs.PUT {
+// protectEventFields rejects PUT requests that modify an event.
+// Events are immutable; only the processing outcome fields (processing_state,
+// alarm_id, definition_id, processed_at) may be updated by the processing stage.
+func protectEventFields(incoming *alm.Event, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.PUT {
+		return nil
+	}
+
+	existing, err := GetEvent(incoming.EventId, vnic)
+	if err != nil {
+		return fmt.Errorf("cannot verify event fields: %w", err)
+	}
+	if existing == nil {
+		return errors.New("Events are immutable and cannot be updated")
+	}
+
+	if incoming.EventType != existing.EventType ||
+		incoming.NodeId != existing.NodeId ||
+		incoming.NodeName != existing.NodeName ||
+		incoming.SourceIdentifier != existing.SourceIdentifier ||
+		incoming.Severity != existing.Severity ||
+		incoming.Message != existing.Message ||
+		incoming.RawData != existing.RawData ||
+		incoming.Category != existing.Category ||
+		incoming.Subcategory != existing.Subcategory ||
+		incoming.OccurredAt != existing.OccurredAt ||
+		incoming.ReceivedAt != existing.ReceivedAt ||
+		!sameAttributes(incoming.Attributes, existing.Attributes) {
 		return errors.New("Events are immutable and cannot be updated")
 	}
 	return nil
 }
 
+func sameAttributes(a, b []*alm.EventAttribute) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Key != b[i].Key || a[i].Value != b[i].Value {
+			return false
+		}
+	}
+	return true
+}
+
 func newEventServiceCallback(vnic ifs.IVNic) ifs.IServiceCallback {
-	return common.NewValidation(&alm.Event{}, vnic).
-		BeforeAction(rejectPut).
+	return common.Immutable("Events", l8common.NewValidation(&alm.Event{}, vnic).
+		BeforeAction(protectEventFields).
+		BeforeAction(normalizeEvent).
 		Require(func(e interface{}) string { return e.(*alm.Event).EventId }, "EventId").
 		Enum(func(e interface{}) int32 { return int32(e.(*alm.Event).EventType) }, alm.AlmEventType_name, "EventType").
 		Require(func(e interface{}) string { return e.(*alm.Event).NodeId }, "NodeId").
 		Require(func(e interface{}) string { return e.(*alm.Event).Message }, "Message").
-		Build()
+		After(runProcessing).
+		After(runHeartbeat).
+		Build())
 }
@@ -0,0 +1,109 @@
+package events
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/alm/heartbeat"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8common "github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"time"
+)
+
+// heartbeatPruneInterval is how often expired heartbeat events are deleted.
+const heartbeatPruneInterval = time.Hour
+
+var heartbeats = heartbeat.NewTracker(recentHeartbeats)
+
+// StartHeartbeatTracker rebuilds the heartbeat tracker from recent heartbeat
+// events, starts raising heartbeat lost alarms and starts pruning heartbeat
+// events past HEARTBEAT_RETENTION_SECONDS.
+func StartHeartbeatTracker(vnic ifs.IVNic) {
+	heartbeats.Start(vnic)
+	go func() {
+		ticker := time.NewTicker(heartbeatPruneInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := PruneHeartbeats(time.Now().Unix(), vnic); err != nil {
+				fmt.Printf("[events] failed to prune heartbeat events: %v\n", err)
+			}
+		}
+	}()
+}
+
+// HeartbeatTracker returns the tracker fed by the heartbeat events posted to
+// this service.
+func HeartbeatTracker() *heartbeat.Tracker {
+	return heartbeats
+}
+
+// runHeartbeat is called after an event is persisted (POST) and feeds
+// heartbeat events to the heartbeat tracker.
+func runHeartbeat(event *alm.Event, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST || event.EventType != alm.AlmEventType_ALM_EVENT_TYPE_HEARTBEAT {
+		return nil
+	}
+	heartbeats.Observe(event, vnic)
+	return nil
+}
+
+// recentHeartbeats returns the heartbeat events that occurred since a time,
+// or were received since then when they carry no occurrence time.
+func recentHeartbeats(since int64, vnic ifs.IVNic) ([]*alm.Event, error) {
+	var result []*alm.Event
+	for _, where := range eventTimes(">=", since) {
+		evtsRaw, err := l8common.GetEntitiesByQuery(ServiceName, ServiceArea,
+			fmt.Sprintf("select * from Event where EventType=%d and %s", alm.AlmEventType_ALM_EVENT_TYPE_HEARTBEAT, where),
+			vnic,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, raw := range evtsRaw {
+			result = append(result, raw.(*alm.Event))
+		}
+	}
+	return result, nil
+}
+
+// PruneHeartbeats deletes the heartbeat events older than
+// HEARTBEAT_RETENTION_SECONDS at a time, keeping at least the
+// HEARTBEAT_HISTORY_SECONDS the tracker is rebuilt from.
+func PruneHeartbeats(now int64, vnic ifs.IVNic) error {
+	retention := common.HEARTBEAT_RETENTION_SECONDS
+	if retention <= 0 {
+		return nil
+	}
+	if retention < common.HEARTBEAT_HISTORY_SECONDS {
+		retention = common.HEARTBEAT_HISTORY_SECONDS
+	}
+	handler, ok := Events(vnic)
+	if !ok {
+		return fmt.Errorf("Event service not available")
+	}
+	for _, where := range eventTimes("<", now-retention) {
+		query := fmt.Sprintf("select * from Event where EventType=%d and %s", alm.AlmEventType_ALM_EVENT_TYPE_HEARTBEAT, where)
+		elems, err := object.NewQuery(query, vnic.Resources())
+		if err != nil {
+			return err
+		}
+		// Events are immutable to everyone but the engines
+		var resp ifs.IElements
+		common.Internal(elems.Element(), func() {
+			resp = handler.Delete(elems, vnic)
+		})
+		if resp.Error() != nil {
+			return resp.Error()
+		}
+	}
+	return nil
+}
+
+// eventTimes returns the conditions comparing the time of an event with t: its occurrence time, or its receive time when it has none.
+func eventTimes(op string, t int64) []string {
+	return []string{
+		fmt.Sprintf("OccurredAt>0 and OccurredAt%s%d", op, t),
+		fmt.Sprintf("OccurredAt=0 and ReceivedAt%s%d", op, t),
+	}
+}
@@ -0,0 +1,29 @@
+package events
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/alm/normalization"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+)
+
+// normalizeEvent is called before a new event is persisted (POST) and runs
+// the normalization rules over it. Events posted with a processing state
+// past NEW (e.g. imported history) and events restored from the archive are
+// kept as-is. A failure to load the
+// rules is logged and the event is stored unnormalized rather than lost.
+func normalizeEvent(event *alm.Event, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST || common.IsQuiet(event) {
+		return nil
+	}
+	if event.ProcessingState != l8events.EventState_EVENT_STATE_UNSPECIFIED &&
+		event.ProcessingState != l8events.EventState_EVENT_STATE_NEW {
+		return nil
+	}
+	if err := normalization.Normalize(event, vnic); err != nil {
+		fmt.Printf("[events] event %s stored without normalization: %v\n", event.EventId, err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package events
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/alm/processing"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8common "github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+)
+
+var procEngine = processing.NewEngine(matchedEvents)
+
+// runProcessing is called after an event is persisted (POST).
+// It matches the event against active alarm definitions, raises or updates
+// the resulting alarm, and stores the processing outcome back on the event.
+// Events posted with a processing state past NEW (e.g. imported history) and
+// events restored from the archive are kept as-is.
+func runProcessing(event *alm.Event, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST || common.IsQuiet(event) {
+		return nil
+	}
+	if event.ProcessingState != l8events.EventState_EVENT_STATE_UNSPECIFIED &&
+		event.ProcessingState != l8events.EventState_EVENT_STATE_NEW {
+		return nil
+	}
+
+	if err := procEngine.Process(event, vnic); err != nil {
+		return fmt.Errorf("failed to process event %s: %w", event.EventId, err)
+	}
+
+	if err := l8common.PutEntity(ServiceName, ServiceArea, event, vnic); err != nil {
+		return fmt.Errorf("failed to record processing result for event %s: %w", event.EventId, err)
+	}
+	return nil
+}
+
+// matchedEvents returns the events previously matched to a definition on a
+// node, limited to those at or after since when it is set.
+func matchedEvents(definitionId, nodeId string, since int64, vnic ifs.IVNic) ([]*alm.Event, error) {
+	query := fmt.Sprintf("select * from Event where DefinitionId=%s and NodeId=%s", definitionId, nodeId)
+	wheres := []string{""}
+	if since > 0 {
+		wheres = eventTimes(">=", since)
+	}
+	var result []*alm.Event
+	for _, where := range wheres {
+		q := query
+		if where != "" {
+			q += " and " + where
+		}
+		evtsRaw, err := l8common.GetEntitiesByQuery(ServiceName, ServiceArea, q, vnic)
+		if err != nil {
+			return nil, err
+		}
+		for _, raw := range evtsRaw {
+			result = append(result, raw.(*alm.Event))
+		}
+	}
+	return result, nil
+}
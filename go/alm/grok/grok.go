@@ -0,0 +1,103 @@
+package grok
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// patterns are the grok patterns available as %{NAME} or %{NAME:capture}.
+// Patterns may reference other patterns. IPV6 needs all eight groups or a
+// "::" with at least one group, so times like 10:00:00 are not addresses.
+var patterns = map[string]string{
+	"WORD":              `\b\w+\b`,
+	"NOTSPACE":          `\S+`,
+	"SPACE":             `\s*`,
+	"DATA":              `.*?`,
+	"GREEDYDATA":        `.*`,
+	"INT":               `[+-]?\d+`,
+	"POSINT":            `\b[1-9]\d*\b`,
+	"NUMBER":            `[+-]?(?:\d+(?:\.\d*)?|\.\d+)`,
+	"USERNAME":          `[a-zA-Z0-9._-]+`,
+	"QUOTEDSTRING":      `"(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'`,
+	"UUID":              `[A-Fa-f0-9]{8}-(?:[A-Fa-f0-9]{4}-){3}[A-Fa-f0-9]{12}`,
+	"MAC":               `(?:[A-Fa-f0-9]{2}[:-]){5}[A-Fa-f0-9]{2}|(?:[A-Fa-f0-9]{4}\.){2}[A-Fa-f0-9]{4}`,
+	"IPV4":              `\b(?:(?:25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(?:25[0-5]|2[0-4]\d|1?\d?\d)\b`,
+	"IPV6":              `\b(?:(?:[A-Fa-f0-9]{1,4}:){7}[A-Fa-f0-9]{1,4}|(?:[A-Fa-f0-9]{1,4}:){1,6}(?::[A-Fa-f0-9]{1,4}){1,6}|(?:[A-Fa-f0-9]{1,4}:){1,7}:)|:(?::[A-Fa-f0-9]{1,4}){1,7}`,
+	"IP":                `%{IPV4}|%{IPV6}`,
+	"HOSTNAME":          `\b[0-9A-Za-z](?:[0-9A-Za-z-]{0,62})(?:\.[0-9A-Za-z](?:[0-9A-Za-z-]{0,62}))*\.?\b`,
+	"IPORHOST":          `%{IP}|%{HOSTNAME}`,
+	"HOSTPORT":          `%{IPORHOST}:%{POSINT}`,
+	"PATH":              `(?:/[^/\s]*)+`,
+	"URI":               `[A-Za-z][A-Za-z0-9+.-]*://\S+`,
+	"INTERFACE":         `[A-Za-z][A-Za-z-]*\d+(?:/\d+)*(?:\.\d+)?`,
+	"LOGLEVEL":          `(?i:emerg(?:ency)?|alert|crit(?:ical)?|err(?:or)?|warn(?:ing)?|notice|info(?:rmational)?|debug|trace|fatal)`,
+	"MONTH":             `\b(?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\b`,
+	"TIME":              `\d{2}:\d{2}(?::\d{2}(?:\.\d+)?)?`,
+	"SYSLOGTIMESTAMP":   `%{MONTH} +\d{1,2} %{TIME}`,
+	"TIMESTAMP_ISO8601": `\d{4}-\d{2}-\d{2}[T ]%{TIME}(?:Z|[+-]\d{2}:?\d{2})?`,
+}
+
+// maxDepth bounds nested pattern references.
+const maxDepth = 10
+
+var reference = regexp.MustCompile(`%\{(\w+)(?::([A-Za-z_][A-Za-z0-9_]*))?\}`)
+
+// Compile expands the grok references of a pattern, %{NAME} or
+// %{NAME:capture}, and compiles the result. A reference with a capture name
+// becomes a named group, like a regular (?P<capture>...) group, so grok and
+// regular expression syntax can be mixed.
+func Compile(pattern string) (*regexp.Regexp, error) {
+	expanded, err := expand(pattern, 0)
+	if err != nil {
+		return nil, err
+	}
+	return regexp.Compile(expanded)
+}
+
+func expand(pattern string, depth int) (string, error) {
+	if depth > maxDepth {
+		return "", fmt.Errorf("grok patterns nested deeper than %d", maxDepth)
+	}
+	var err error
+	result := reference.ReplaceAllStringFunc(pattern, func(ref string) string {
+		if err != nil {
+			return ""
+		}
+		parts := reference.FindStringSubmatch(ref)
+		definition, ok := patterns[parts[1]]
+		if !ok {
+			err = fmt.Errorf("unknown grok pattern %%{%s}", parts[1])
+			return ""
+		}
+		inner, expandErr := expand(definition, depth+1)
+		if expandErr != nil {
+			err = expandErr
+			return ""
+		}
+		if parts[2] == "" {
+			return "(?:" + inner + ")"
+		}
+		return "(?P<" + parts[2] + ">" + inner + ")"
+	})
+	if err != nil {
+		return "", err
+	}
+	return result, nil
+}
+
+// Captures returns the named captures of the first match of re in s, or
+// nil if it does not match. Captures that did not participate are omitted.
+func Captures(re *regexp.Regexp, s string) map[string]string {
+	match := re.FindStringSubmatchIndex(s)
+	if match == nil {
+		return nil
+	}
+	result := make(map[string]string)
+	for i, name := range re.SubexpNames() {
+		if name == "" || match[2*i] < 0 {
+			continue
+		}
+		result[name] = s[match[2*i]:match[2*i+1]]
+	}
+	return result
+}
@@ -0,0 +1,131 @@
+package heartbeat
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/alarmdefinitions"
+	"github.com/saichler/l8alarms/go/alm/alarms"
+	"github.com/saichler/l8alarms/go/alm/autoclear"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8common "github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"strconv"
+	"time"
+)
+
+// ResumedReason is the reason recorded when a heartbeat clears its alarm.
+const ResumedReason = "heartbeat resumed"
+
+// ensureDefinition creates the heartbeat lost alarm definition if it does
+// not exist. It has no event_pattern, so no event matches it.
+func ensureDefinition(vnic ifs.IVNic) error {
+	existing, err := alarmdefinitions.AlarmDefinition(common.HEARTBEAT_DEFINITION_ID, vnic)
+	if err != nil {
+		return fmt.Errorf("failed to get heartbeat lost definition: %w", err)
+	}
+	if existing != nil {
+		return nil
+	}
+	handler, ok := alarmdefinitions.AlarmDefinitions(vnic)
+	if !ok {
+		return fmt.Errorf("AlarmDefinition service not available")
+	}
+	now := time.Now().Unix()
+	resp := handler.Post(object.New(nil, &alm.AlarmDefinition{
+		DefinitionId:    common.HEARTBEAT_DEFINITION_ID,
+		Name:            "Heartbeat Lost",
+		Description:     "No heartbeat from a node and source for the missed intervals of its expected interval",
+		Status:          alm.AlarmDefinitionStatus_ALARM_DEFINITION_STATUS_ACTIVE,
+		DefaultSeverity: l8events.Severity_SEVERITY_MAJOR,
+		EventTypeFilter: alm.AlmEventType_ALM_EVENT_TYPE_HEARTBEAT,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}), vnic)
+	if resp.Error() != nil {
+		return fmt.Errorf("failed to create heartbeat lost definition: %w", resp.Error())
+	}
+	return nil
+}
+
+// raiseLost posts a heartbeat lost alarm for the source and returns its ID.
+// No alarm is raised while the heartbeat lost definition is not ACTIVE.
+func raiseLost(s *source, monitor *alm.HeartbeatMonitor, interval int64, missed int32, now int64, vnic ifs.IVNic) (string, error) {
+	def, err := alarmdefinitions.AlarmDefinition(common.HEARTBEAT_DEFINITION_ID, vnic)
+	if err != nil {
+		return "", err
+	}
+	if def == nil || def.Status != alm.AlarmDefinitionStatus_ALARM_DEFINITION_STATUS_ACTIVE {
+		return "", nil
+	}
+
+	severity := def.DefaultSeverity
+	if monitor != nil && monitor.Severity != l8events.Severity_SEVERITY_UNSPECIFIED {
+		severity = monitor.Severity
+	}
+	attributes := map[string]string{
+		"lastHeartbeat":   strconv.FormatInt(s.last, 10),
+		"intervalSeconds": strconv.FormatInt(interval, 10),
+		"missedIntervals": strconv.Itoa(int(missed)),
+	}
+	if monitor != nil {
+		attributes["heartbeatMonitorId"] = monitor.MonitorId
+	}
+
+	alarm := &alm.Alarm{
+		AlarmId:      ifs.NewUuid(),
+		DefinitionId: def.DefinitionId,
+		Name:         def.Name,
+		Description: fmt.Sprintf("No heartbeat from %s for %d seconds (%d intervals of %d seconds)",
+			s.describe(), now-s.last, missed, interval),
+		State:            l8events.AlarmState_ALARM_STATE_ACTIVE,
+		Severity:         severity,
+		OriginalSeverity: severity,
+		NodeId:           s.nodeId,
+		NodeName:         s.nodeName,
+		SourceIdentifier: s.sourceIdentifier,
+		FirstOccurrence:  now,
+		LastOccurrence:   now,
+		OccurrenceCount:  1,
+		Attributes:       attributes,
+	}
+	handler, ok := alarms.Alarms(vnic)
+	if !ok {
+		return "", fmt.Errorf("Alarm service not available")
+	}
+	resp := handler.Post(object.New(nil, alarm), vnic)
+	if resp.Error() != nil {
+		return "", resp.Error()
+	}
+	return alarm.AlarmId, nil
+}
+
+// clearLost auto-clears a heartbeat lost alarm, unless it is already cleared.
+func clearLost(alarmId string, vnic ifs.IVNic) error {
+	alarm, err := alarms.GetAlarm(alarmId, vnic)
+	if err != nil {
+		return err
+	}
+	if alarm == nil || alarm.State == l8events.AlarmState_ALARM_STATE_CLEARED {
+		return nil
+	}
+	return autoclear.ClearAlarm(alarm, ResumedReason, vnic)
+}
+
+// openLostAlarms returns the heartbeat lost alarms that are not cleared.
+func openLostAlarms(vnic ifs.IVNic) ([]*alm.Alarm, error) {
+	raw, err := l8common.GetEntitiesByQuery(alarms.ServiceName, alarms.ServiceArea,
+		fmt.Sprintf("select * from Alarm where DefinitionId=%s", common.HEARTBEAT_DEFINITION_ID), vnic)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*alm.Alarm, 0, len(raw))
+	for _, r := range raw {
+		a := r.(*alm.Alarm)
+		if a.State != l8events.AlarmState_ALARM_STATE_CLEARED {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
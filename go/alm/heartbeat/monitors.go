@@ -0,0 +1,49 @@
+package heartbeat
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/heartbeatmonitors"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+func activeMonitors(vnic ifs.IVNic) ([]*alm.HeartbeatMonitor, error) {
+	raw, err := common.GetEntitiesByQuery(heartbeatmonitors.ServiceName, heartbeatmonitors.ServiceArea,
+		fmt.Sprintf("select * from HeartbeatMonitor where Status=%d", alm.AlmPolicyStatus_ALM_POLICY_STATUS_ACTIVE),
+		vnic,
+	)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*alm.HeartbeatMonitor, 0, len(raw))
+	for _, m := range raw {
+		result = append(result, m.(*alm.HeartbeatMonitor))
+	}
+	return result, nil
+}
+
+// matchMonitor returns the monitor that matches the node and source most
+// specifically: node and source, then node only, then source only, then
+// neither. Ties go to the lowest monitor ID. It returns nil if none match.
+func matchMonitor(monitors []*alm.HeartbeatMonitor, nodeId, sourceIdentifier string) *alm.HeartbeatMonitor {
+	var best *alm.HeartbeatMonitor
+	bestRank := -1
+	for _, m := range monitors {
+		if (m.NodeId != "" && m.NodeId != nodeId) ||
+			(m.SourceIdentifier != "" && m.SourceIdentifier != sourceIdentifier) {
+			continue
+		}
+		rank := 0
+		if m.NodeId != "" {
+			rank += 2
+		}
+		if m.SourceIdentifier != "" {
+			rank++
+		}
+		if rank > bestRank || (rank == bestRank && m.MonitorId < best.MonitorId) {
+			best, bestRank = m, rank
+		}
+	}
+	return best
+}
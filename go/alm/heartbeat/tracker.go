@@ -0,0 +1,252 @@
+package heartbeat
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8types/go/ifs"
+	"sort"
+	"sync"
+	"time"
+)
+
+// History returns the heartbeat events that occurred since a time.
+// It is used to rebuild the tracker, e.g. after a service restart.
+type History func(since int64, vnic ifs.IVNic) ([]*alm.Event, error)
+
+// sweepInterval is how often heartbeats are checked for being lost.
+const sweepInterval = 10 * time.Second
+
+// maxGaps is how many recent gaps between heartbeats are kept for learning
+// the interval of a source.
+const maxGaps = 10
+
+// source is the heartbeat state of one node and source identifier.
+type source struct {
+	nodeId           string
+	nodeName         string
+	sourceIdentifier string
+	last             int64
+	gaps             []int64
+	// alarmId is the open "heartbeat lost" alarm, "" while heartbeats arrive.
+	alarmId string
+	// raising is set while a sweep raises the alarm outside the tracker lock.
+	raising bool
+}
+
+// Tracker follows the ALM_EVENT_TYPE_HEARTBEAT events of each node and
+// source identifier. When no heartbeat arrives for the missed intervals of
+// its expected interval, it raises a "heartbeat lost" alarm; the next
+// heartbeat auto-clears it.
+type Tracker struct {
+	mtx     sync.Mutex
+	history History
+	sources map[string]*source
+}
+
+func NewTracker(history History) *Tracker {
+	return &Tracker{history: history, sources: make(map[string]*source)}
+}
+
+// Start creates the heartbeat lost definition if missing, rebuilds the
+// tracker from recent heartbeat events and open alarms, and starts the
+// background sweep.
+func (t *Tracker) Start(vnic ifs.IVNic) {
+	if err := ensureDefinition(vnic); err != nil {
+		fmt.Printf("[heartbeat] %v\n", err)
+	}
+	if err := t.Rebuild(vnic); err != nil {
+		fmt.Printf("[heartbeat] failed to rebuild heartbeat state: %v\n", err)
+	}
+	go func() {
+		ticker := time.NewTicker(sweepInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			t.Sweep(time.Now().Unix(), vnic)
+		}
+	}()
+}
+
+// Observe records a heartbeat event. A heartbeat of a lost source clears
+// its alarm. The alarm is cleared after the tracker lock is released; if
+// that fails, the source stays lost so the next heartbeat retries.
+func (t *Tracker) Observe(event *alm.Event, vnic ifs.IVNic) {
+	t.mtx.Lock()
+	s := record(t.sources, event)
+	alarmId := s.alarmId
+	s.alarmId = ""
+	t.mtx.Unlock()
+
+	if alarmId == "" {
+		return
+	}
+	if err := clearLost(alarmId, vnic); err != nil {
+		fmt.Printf("[heartbeat] failed to clear alarm %s: %v\n", alarmId, err)
+		t.mtx.Lock()
+		if s.alarmId == "" {
+			s.alarmId = alarmId
+		}
+		t.mtx.Unlock()
+	}
+}
+
+// lostSource is a source found lost by a sweep, with the snapshot of its
+// state the alarm is raised from.
+type lostSource struct {
+	source   *source
+	snapshot source
+	monitor  *alm.HeartbeatMonitor
+	interval int64
+	missed   int32
+}
+
+// Sweep raises a heartbeat lost alarm for every source whose last heartbeat
+// is at least its missed intervals old at now. Sources without a monitor
+// and without enough heartbeats to learn their interval are skipped. The
+// lost sources are picked under the tracker lock and their alarms raised
+// after it is released; a source that got a heartbeat meanwhile has its new
+// alarm cleared right away.
+func (t *Tracker) Sweep(now int64, vnic ifs.IVNic) {
+	monitors, err := activeMonitors(vnic)
+	if err != nil {
+		fmt.Printf("[heartbeat] failed to query heartbeat monitors: %v\n", err)
+		return
+	}
+
+	var lost []lostSource
+	t.mtx.Lock()
+	for _, s := range t.sources {
+		if s.alarmId != "" || s.raising || s.last == 0 {
+			continue
+		}
+		monitor := matchMonitor(monitors, s.nodeId, s.sourceIdentifier)
+		interval, missed := expectation(monitor, s)
+		if interval <= 0 || now-s.last < interval*int64(missed) {
+			continue
+		}
+		s.raising = true
+		lost = append(lost, lostSource{source: s, snapshot: *s, monitor: monitor, interval: interval, missed: missed})
+	}
+	t.mtx.Unlock()
+
+	for _, l := range lost {
+		alarmId, err := raiseLost(&l.snapshot, l.monitor, l.interval, l.missed, now, vnic)
+		if err != nil {
+			fmt.Printf("[heartbeat] failed to raise heartbeat lost alarm for %s/%s: %v\n",
+				l.snapshot.nodeId, l.snapshot.sourceIdentifier, err)
+		}
+
+		t.mtx.Lock()
+		l.source.raising = false
+		resumed := l.source.last != l.snapshot.last
+		if alarmId != "" && !resumed {
+			l.source.alarmId = alarmId
+		}
+		t.mtx.Unlock()
+
+		if alarmId != "" && resumed {
+			if err := clearLost(alarmId, vnic); err != nil {
+				fmt.Printf("[heartbeat] failed to clear alarm %s: %v\n", alarmId, err)
+			}
+		}
+	}
+}
+
+// Rebuild replaces the tracker state with the heartbeat events of the last
+// HEARTBEAT_HISTORY_SECONDS and the open heartbeat lost alarms.
+func (t *Tracker) Rebuild(vnic ifs.IVNic) error {
+	events, err := t.history(time.Now().Unix()-common.HEARTBEAT_HISTORY_SECONDS, vnic)
+	if err != nil {
+		return err
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return eventTime(events[i]) < eventTime(events[j])
+	})
+	sources := make(map[string]*source)
+	for _, event := range events {
+		record(sources, event)
+	}
+
+	lost, err := openLostAlarms(vnic)
+	if err != nil {
+		return err
+	}
+	for _, alarm := range lost {
+		key := sourceKey(alarm.NodeId, alarm.SourceIdentifier)
+		s, ok := sources[key]
+		if !ok {
+			s = &source{nodeId: alarm.NodeId, nodeName: alarm.NodeName, sourceIdentifier: alarm.SourceIdentifier}
+			sources[key] = s
+		}
+		s.alarmId = alarm.AlarmId
+	}
+
+	t.mtx.Lock()
+	t.sources = sources
+	t.mtx.Unlock()
+	return nil
+}
+
+// record folds a heartbeat into the state of its source. Heartbeats older
+// than the last one only count as seen.
+func record(sources map[string]*source, event *alm.Event) *source {
+	key := sourceKey(event.NodeId, event.SourceIdentifier)
+	s, ok := sources[key]
+	if !ok {
+		s = &source{nodeId: event.NodeId, sourceIdentifier: event.SourceIdentifier}
+		sources[key] = s
+	}
+	if event.NodeName != "" {
+		s.nodeName = event.NodeName
+	}
+	at := eventTime(event)
+	if at <= s.last {
+		return s
+	}
+	if s.last > 0 {
+		s.gaps = append(s.gaps, at-s.last)
+		if len(s.gaps) > maxGaps {
+			s.gaps = s.gaps[len(s.gaps)-maxGaps:]
+		}
+	}
+	s.last = at
+	return s
+}
+
+// expectation returns the expected interval of a source, in seconds, and
+// how many may be missed. The interval is the monitor's, or the median of
+// the recent gaps once HEARTBEAT_LEARN_SAMPLES gaps were seen; 0 if unknown.
+func expectation(monitor *alm.HeartbeatMonitor, s *source) (int64, int32) {
+	missed := common.HEARTBEAT_MISSED_INTERVALS
+	if monitor != nil && monitor.MissedIntervals > 0 {
+		missed = monitor.MissedIntervals
+	}
+	if monitor != nil && monitor.IntervalSeconds > 0 {
+		return int64(monitor.IntervalSeconds), missed
+	}
+	if len(s.gaps) < common.HEARTBEAT_LEARN_SAMPLES {
+		return 0, missed
+	}
+	sorted := append([]int64{}, s.gaps...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted[len(sorted)/2], missed
+}
+
+func (s *source) describe() string {
+	if s.sourceIdentifier == "" {
+		return s.nodeId
+	}
+	return s.nodeId + " " + s.sourceIdentifier
+}
+
+func sourceKey(nodeId, sourceIdentifier string) string {
+	return nodeId + "\x00" + sourceIdentifier
+}
+
+// eventTime returns when the event occurred, falling back to when it was received.
+func eventTime(event *alm.Event) int64 {
+	if event.OccurredAt != 0 {
+		return event.OccurredAt
+	}
+	return event.ReceivedAt
+}
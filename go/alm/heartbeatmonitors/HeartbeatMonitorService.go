@@ -0,0 +1,31 @@
+package heartbeatmonitors
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+const (
+	ServiceName = "HbMonitor"
+	ServiceArea = byte(10)
+)
+
+func Activate(creds, dbname string, vnic ifs.IVNic) {
+	common.ActivateService(common.ServiceConfig{
+		ServiceName: ServiceName, ServiceArea: ServiceArea,
+		PrimaryKey: "MonitorId", Callback: newHeartbeatMonitorServiceCallback(vnic),
+	}, &alm.HeartbeatMonitor{}, &alm.HeartbeatMonitorList{}, creds, dbname, vnic)
+}
+
+func HeartbeatMonitors(vnic ifs.IVNic) (ifs.IServiceHandler, bool) {
+	return common.ServiceHandler(ServiceName, ServiceArea, vnic)
+}
+
+func HeartbeatMonitor(id string, vnic ifs.IVNic) (*alm.HeartbeatMonitor, error) {
+	result, err := common.GetEntity(ServiceName, ServiceArea, &alm.HeartbeatMonitor{MonitorId: id}, vnic)
+	if err != nil || result == nil {
+		return nil, err
+	}
+	return result.(*alm.HeartbeatMonitor), nil
+}
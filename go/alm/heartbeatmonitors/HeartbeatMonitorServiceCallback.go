@@ -0,0 +1,33 @@
+package heartbeatmonitors
+
+import (
+	"errors"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+)
+
+func newHeartbeatMonitorServiceCallback(vnic ifs.IVNic) ifs.IServiceCallback {
+	return common.NewValidation(&alm.HeartbeatMonitor{}, vnic).
+		Require(func(e interface{}) string { return e.(*alm.HeartbeatMonitor).MonitorId }, "MonitorId").
+		Require(func(e interface{}) string { return e.(*alm.HeartbeatMonitor).Name }, "Name").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.HeartbeatMonitor).Status) }, alm.AlmPolicyStatus_name, "Status").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.HeartbeatMonitor).Severity) }, l8events.Severity_name, "Severity").
+		BeforeAction(validateIntervals).
+		Build()
+}
+
+// validateIntervals rejects negative intervals.
+func validateIntervals(monitor *alm.HeartbeatMonitor, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST && action != ifs.PUT {
+		return nil
+	}
+	if monitor.IntervalSeconds < 0 {
+		return errors.New("intervalSeconds cannot be negative")
+	}
+	if monitor.MissedIntervals < 0 {
+		return errors.New("missedIntervals cannot be negative")
+	}
+	return nil
+}
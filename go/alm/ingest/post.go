@@ -0,0 +1,40 @@
+package ingest
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/events"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"time"
+)
+
+// PostEvent posts an event received from an external source to the Event
+// service, where it is processed like any other event. The event ID, the
+// NEW processing state and the receive time are filled in when unset.
+func PostEvent(event *alm.Event, vnic ifs.IVNic) error {
+	if event.EventId == "" {
+		event.EventId = ifs.NewUuid()
+	}
+	if event.ProcessingState == l8events.EventState_EVENT_STATE_UNSPECIFIED {
+		event.ProcessingState = l8events.EventState_EVENT_STATE_NEW
+	}
+	now := time.Now().Unix()
+	if event.ReceivedAt == 0 {
+		event.ReceivedAt = now
+	}
+	if event.OccurredAt == 0 {
+		event.OccurredAt = event.ReceivedAt
+	}
+
+	handler, ok := events.Events(vnic)
+	if !ok {
+		return fmt.Errorf("Event service not available")
+	}
+	resp := handler.Post(object.New(nil, event), vnic)
+	if resp.Error() != nil {
+		return resp.Error()
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package ingest
+
+import (
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// topologyRefreshInterval is how long the topology node IDs are cached
+// before they are fetched again.
+const topologyRefreshInterval = 5 * time.Minute
+
+// NodeResolver maps the network address of an event source to a topology
+// node_id. An address resolves to the topology node whose ID is the address
+// itself, or one of its reverse DNS names (full or short). Addresses that
+// match no node resolve to themselves, so events always carry a node_id.
+type NodeResolver struct {
+	mu          sync.Mutex
+	nodes       map[string]bool
+	refreshedAt time.Time
+	resolved    map[string]string
+}
+
+func NewNodeResolver() *NodeResolver {
+	return &NodeResolver{resolved: make(map[string]string)}
+}
+
+// Resolve returns the node_id of the address. The topology fetch and the
+// reverse DNS lookup run without holding the lock, which only guards the cache.
+func (r *NodeResolver) Resolve(address string, vnic ifs.IVNic) string {
+	nodes, resolved, nodeId, ok := r.cached(address, vnic)
+	if ok {
+		return nodeId
+	}
+
+	nodeId = address
+	if !nodes[address] {
+		for _, name := range reverseNames(address) {
+			if nodes[name] {
+				nodeId = name
+				break
+			}
+		}
+	}
+
+	r.mu.Lock()
+	resolved[address] = nodeId
+	r.mu.Unlock()
+	return nodeId
+}
+
+// cached returns the topology node IDs, refreshing them when stale, the
+// resolution cache that goes with them, and the cached node_id of the address.
+// A stale cache is replaced only once its refresh completes, so concurrent
+// callers keep resolving against the previous node IDs meanwhile.
+func (r *NodeResolver) cached(address string, vnic ifs.IVNic) (map[string]bool, map[string]string, string, bool) {
+	r.mu.Lock()
+	refresh := time.Since(r.refreshedAt) > topologyRefreshInterval
+	if refresh {
+		r.refreshedAt = time.Now()
+	}
+	r.mu.Unlock()
+
+	if refresh {
+		nodes := fetchNodeIds(vnic)
+		r.mu.Lock()
+		r.nodes = nodes
+		r.resolved = make(map[string]string)
+		r.mu.Unlock()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	nodeId, ok := r.resolved[address]
+	return r.nodes, r.resolved, nodeId, ok
+}
+
+// reverseNames lists the reverse DNS names of an IP address, each full and
+// short, most specific first.
+func reverseNames(address string) []string {
+	if net.ParseIP(address) == nil {
+		return nil
+	}
+	names, err := net.LookupAddr(address)
+	if err != nil {
+		return nil
+	}
+	var result []string
+	for _, name := range names {
+		name = strings.TrimSuffix(name, ".")
+		result = append(result, name)
+		if short, _, ok := strings.Cut(name, "."); ok {
+			result = append(result, short)
+		}
+	}
+	return result
+}
+
+// fetchNodeIds collects the node IDs of every available topology.
+func fetchNodeIds(vnic ifs.IVNic) map[string]bool {
+	nodes := make(map[string]bool)
+	topoListHandler, ok := vnic.Resources().Services().ServiceHandler("TopoList", 0)
+	if !ok {
+		return nodes
+	}
+	resp := topoListHandler.Get(nil, vnic)
+	if resp == nil || resp.Error() != nil {
+		return nodes
+	}
+
+	for _, elem := range resp.Elements() {
+		md, ok := elem.(*l8topo.L8TopologyMetadata)
+		if !ok {
+			continue
+		}
+		handler, ok := vnic.Resources().Services().ServiceHandler(md.ServiceName, byte(md.ServiceArea))
+		if !ok {
+			continue
+		}
+		topoResp := handler.Get(object.New(nil, &l8topo.L8TopologyQuery{}), vnic)
+		if topoResp == nil || topoResp.Error() != nil {
+			continue
+		}
+		if topo, ok := topoResp.Element().(*l8topo.L8Topology); ok && topo != nil {
+			for id := range topo.Nodes {
+				nodes[id] = true
+			}
+		}
+	}
+	return nodes
+}
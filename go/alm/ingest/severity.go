@@ -0,0 +1,45 @@
+package ingest
+
+import (
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"strconv"
+	"strings"
+)
+
+// severityNames are the severity spellings used by external sources.
+var severityNames = map[string]l8events.Severity{
+	"critical":      l8events.Severity_SEVERITY_CRITICAL,
+	"crit":          l8events.Severity_SEVERITY_CRITICAL,
+	"fatal":         l8events.Severity_SEVERITY_CRITICAL,
+	"emergency":     l8events.Severity_SEVERITY_CRITICAL,
+	"page":          l8events.Severity_SEVERITY_CRITICAL,
+	"major":         l8events.Severity_SEVERITY_MAJOR,
+	"error":         l8events.Severity_SEVERITY_MAJOR,
+	"high":          l8events.Severity_SEVERITY_MAJOR,
+	"minor":         l8events.Severity_SEVERITY_MINOR,
+	"medium":        l8events.Severity_SEVERITY_MINOR,
+	"warning":       l8events.Severity_SEVERITY_WARNING,
+	"warn":          l8events.Severity_SEVERITY_WARNING,
+	"low":           l8events.Severity_SEVERITY_WARNING,
+	"info":          l8events.Severity_SEVERITY_INFO,
+	"informational": l8events.Severity_SEVERITY_INFO,
+	"notice":        l8events.Severity_SEVERITY_INFO,
+	"none":          l8events.Severity_SEVERITY_INFO,
+}
+
+// ParseSeverity returns the event severity of a severity reported by an
+// external source: a name such as "critical", "error" or "warn"
+// (case-insensitive), or a severity number (1-5). It returns
+// SEVERITY_UNSPECIFIED for anything else.
+func ParseSeverity(value string) l8events.Severity {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if severity, ok := severityNames[value]; ok {
+		return severity
+	}
+	if n, err := strconv.Atoi(value); err == nil {
+		if _, ok := l8events.Severity_name[int32(n)]; ok {
+			return l8events.Severity(n)
+		}
+	}
+	return l8events.Severity_SEVERITY_UNSPECIFIED
+}
@@ -0,0 +1,137 @@
+package jsonpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Step is one step of a compiled path: an object key, an array
+// index, or a wildcard over the elements of an array or object.
+type Step struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// Compile parses a path in the JSONPath subset used to map JSON payloads to
+// events: the root "$", ".key", "['key']" or "[\"key\"]", "[n]" (negative
+// counts from the end), ".*" and "[*]". The leading "$" is optional.
+func Compile(path string) ([]Step, error) {
+	path = strings.TrimSpace(path)
+	path = strings.TrimPrefix(path, "$")
+	var steps []Step
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			i++
+			end := i
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+			if end == i {
+				return nil, fmt.Errorf("invalid path %q: empty key at %d", path, i)
+			}
+			if path[i:end] == "*" {
+				steps = append(steps, Step{wildcard: true})
+			} else {
+				steps = append(steps, Step{key: path[i:end]})
+			}
+			i = end
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: unterminated [", path)
+			}
+			inner := strings.TrimSpace(path[i+1 : i+end])
+			i += end + 1
+			switch {
+			case inner == "*":
+				steps = append(steps, Step{wildcard: true})
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				steps = append(steps, Step{key: inner[1 : len(inner)-1]})
+			default:
+				n, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid path %q: bad index [%s]", path, inner)
+				}
+				steps = append(steps, Step{index: n, isIndex: true})
+			}
+		default:
+			// "$key" without the dot, or a bare "key"
+			if len(steps) > 0 {
+				return nil, fmt.Errorf("invalid path %q at %d", path, i)
+			}
+			path = "." + path[i:]
+			i = 0
+		}
+	}
+	return steps, nil
+}
+
+// Select returns the values the path selects in a decoded JSON document.
+func Select(path string, doc interface{}) ([]interface{}, error) {
+	steps, err := Compile(path)
+	if err != nil {
+		return nil, err
+	}
+	current := []interface{}{doc}
+	for _, step := range steps {
+		var next []interface{}
+		for _, value := range current {
+			switch v := value.(type) {
+			case map[string]interface{}:
+				if step.wildcard {
+					for _, child := range v {
+						next = append(next, child)
+					}
+				} else if child, ok := v[step.key]; ok && !step.isIndex {
+					next = append(next, child)
+				}
+			case []interface{}:
+				if step.wildcard {
+					next = append(next, v...)
+				} else if step.isIndex {
+					index := step.index
+					if index < 0 {
+						index += len(v)
+					}
+					if index >= 0 && index < len(v) {
+						next = append(next, v[index])
+					}
+				}
+			}
+		}
+		current = next
+	}
+	return current, nil
+}
+
+// SelectString returns the first value the path selects, as a string; ""
+// if the path is empty or selects nothing.
+func SelectString(path string, doc interface{}) (string, error) {
+	if strings.TrimSpace(path) == "" {
+		return "", nil
+	}
+	values, err := Select(path, doc)
+	if err != nil || len(values) == 0 {
+		return "", err
+	}
+	return toString(values[0]), nil
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -1,6 +1,7 @@
 package maintenancewindows
 
 import (
+	"fmt"
 	"github.com/saichler/l8alarms/go/types/alm"
 	"github.com/saichler/l8common/go/common"
 	l8events "github.com/saichler/l8types/go/types/l8events"
@@ -14,5 +15,52 @@ func newMaintenanceWindowServiceCallback(vnic ifs.IVNic) ifs.IServiceCallback {
 		Enum(func(e interface{}) int32 { return int32(e.(*alm.MaintenanceWindow).Status) }, l8events.MaintenanceStatus_name, "Status").
 		DateNotZero(func(e interface{}) int64 { return e.(*alm.MaintenanceWindow).StartTime }, "StartTime").
 		DateNotZero(func(e interface{}) int64 { return e.(*alm.MaintenanceWindow).EndTime }, "EndTime").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.MaintenanceWindow).Recurrence) }, l8events.RecurrenceType_name, "Recurrence").
+		BeforeAction(validateSchedule).
 		Build()
 }
+
+// validateSchedule ensures the window has a positive duration, which recurring
+// windows also use as the length of every occurrence. A PATCH only carries the
+// fields it changes, so it is checked against the stored window merged with it.
+func validateSchedule(w *alm.MaintenanceWindow, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST && action != ifs.PUT && action != ifs.PATCH {
+		return nil
+	}
+	if action == ifs.PATCH {
+		existing, err := MaintenanceWindow(w.WindowId, vnic)
+		if err != nil {
+			return fmt.Errorf("cannot verify window schedule: %w", err)
+		}
+		if existing != nil {
+			w = mergeSchedule(existing, w)
+		}
+	}
+	if w.EndTime <= w.StartTime {
+		return fmt.Errorf("EndTime must be after StartTime")
+	}
+	if w.RecurrenceInterval < 0 {
+		return fmt.Errorf("RecurrenceInterval cannot be negative")
+	}
+	return nil
+}
+
+// mergeSchedule returns the schedule of the stored window with the fields a
+// PATCH sets applied on top of it.
+func mergeSchedule(existing, patch *alm.MaintenanceWindow) *alm.MaintenanceWindow {
+	merged := &alm.MaintenanceWindow{
+		StartTime:          existing.StartTime,
+		EndTime:            existing.EndTime,
+		RecurrenceInterval: existing.RecurrenceInterval,
+	}
+	if patch.StartTime != 0 {
+		merged.StartTime = patch.StartTime
+	}
+	if patch.EndTime != 0 {
+		merged.EndTime = patch.EndTime
+	}
+	if patch.RecurrenceInterval != 0 {
+		merged.RecurrenceInterval = patch.RecurrenceInterval
+	}
+	return merged
+}
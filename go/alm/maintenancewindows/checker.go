@@ -50,9 +50,11 @@ func Check(alarm *alm.Alarm, vnic ifs.IVNic) CheckResult {
 	return CheckResult{}
 }
 
-// isTimeActive checks if the maintenance window is active at the given time.
+// isTimeActive checks if the maintenance window, or one of its recurring
+// occurrences, is active at the given time.
 func isTimeActive(w *alm.MaintenanceWindow, now int64) bool {
-	return now >= w.StartTime && now <= w.EndTime
+	_, _, ok := occurrenceAt(w, now)
+	return ok
 }
 
 // matchesScope checks if the alarm's node matches the maintenance window scope.
@@ -0,0 +1,100 @@
+package maintenancewindows
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"time"
+)
+
+// occurrenceAt returns the occurrence of the window that covers the given time.
+// Non-recurring windows have a single occurrence, start_time to end_time.
+// Recurring windows repeat every recurrence_interval days, weeks or months
+// (an interval of 0 means 1) from start_time, each lasting the original
+// end_time - start_time, and do not end.
+func occurrenceAt(w *alm.MaintenanceWindow, now int64) (start, end int64, ok bool) {
+	if now < w.StartTime || w.EndTime <= w.StartTime {
+		return 0, 0, false
+	}
+	duration := w.EndTime - w.StartTime
+
+	if !isRecurring(w) {
+		return w.StartTime, w.EndTime, now <= w.EndTime
+	}
+
+	// The latest occurrence starting at or before now, and the one before it
+	// in case occurrences are longer than the recurrence period.
+	n := occurrencesBefore(w, now)
+	for i := n; i >= 0 && i >= n-1; i-- {
+		start = occurrenceStart(w, i)
+		if start <= now && now <= start+duration {
+			return start, start + duration, true
+		}
+	}
+	return 0, 0, false
+}
+
+func isRecurring(w *alm.MaintenanceWindow) bool {
+	switch w.Recurrence {
+	case l8events.RecurrenceType_RECURRENCE_TYPE_DAILY,
+		l8events.RecurrenceType_RECURRENCE_TYPE_WEEKLY,
+		l8events.RecurrenceType_RECURRENCE_TYPE_MONTHLY:
+		return true
+	}
+	return false
+}
+
+func interval(w *alm.MaintenanceWindow) int {
+	if w.RecurrenceInterval <= 0 {
+		return 1
+	}
+	return int(w.RecurrenceInterval)
+}
+
+// occurrencesBefore returns the index of the latest occurrence starting at or
+// before now (0 is the original start_time).
+func occurrencesBefore(w *alm.MaintenanceWindow, now int64) int {
+	first := time.Unix(w.StartTime, 0)
+	t := time.Unix(now, 0)
+
+	var n int
+	switch w.Recurrence {
+	case l8events.RecurrenceType_RECURRENCE_TYPE_DAILY:
+		n = int((now - w.StartTime) / 86400 / int64(interval(w)))
+	case l8events.RecurrenceType_RECURRENCE_TYPE_WEEKLY:
+		n = int((now - w.StartTime) / (7 * 86400) / int64(interval(w)))
+	case l8events.RecurrenceType_RECURRENCE_TYPE_MONTHLY:
+		months := (t.Year()-first.Year())*12 + int(t.Month()-first.Month())
+		n = months / interval(w)
+	}
+
+	// Calendar arithmetic (DST, month lengths) can overshoot by one period.
+	for n > 0 && occurrenceStart(w, n) > now {
+		n--
+	}
+	for occurrenceStart(w, n+1) <= now {
+		n++
+	}
+	return n
+}
+
+// occurrenceStart returns the start of the n-th occurrence, keeping the
+// original local time of day. Monthly occurrences falling on a day the month
+// does not have (e.g. the 31st) are moved to the month's last day.
+func occurrenceStart(w *alm.MaintenanceWindow, n int) int64 {
+	first := time.Unix(w.StartTime, 0)
+	switch w.Recurrence {
+	case l8events.RecurrenceType_RECURRENCE_TYPE_DAILY:
+		return first.AddDate(0, 0, n*interval(w)).Unix()
+	case l8events.RecurrenceType_RECURRENCE_TYPE_WEEKLY:
+		return first.AddDate(0, 0, 7*n*interval(w)).Unix()
+	case l8events.RecurrenceType_RECURRENCE_TYPE_MONTHLY:
+		month := time.Date(first.Year(), first.Month()+time.Month(n*interval(w)), 1,
+			first.Hour(), first.Minute(), first.Second(), 0, first.Location())
+		day := first.Day()
+		if last := month.AddDate(0, 1, -1).Day(); day > last {
+			day = last
+		}
+		return month.AddDate(0, 0, day-1).Unix()
+	}
+	return w.StartTime
+}
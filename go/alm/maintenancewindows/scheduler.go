@@ -0,0 +1,78 @@
+package maintenancewindows
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"time"
+)
+
+// schedulerInterval is how often window statuses are reconciled with their time ranges.
+const schedulerInterval = 30 * time.Second
+
+// WindowEndHandler is called after an ACTIVE window's occurrence ends, to
+// re-evaluate the alarms the window suppressed.
+type WindowEndHandler func(w *alm.MaintenanceWindow, vnic ifs.IVNic)
+
+// StartScheduler runs the window lifecycle in the background.
+func StartScheduler(vnic ifs.IVNic, onEnd WindowEndHandler) {
+	go func() {
+		ticker := time.NewTicker(schedulerInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			RunLifecycle(vnic, onEnd)
+		}
+	}()
+}
+
+// RunLifecycle moves SCHEDULED windows to ACTIVE at start_time, and ACTIVE
+// windows to COMPLETED at end_time, persisting each change. Recurring windows
+// go back to SCHEDULED between occurrences instead of completing.
+// onEnd is called for every window that stops being ACTIVE.
+func RunLifecycle(vnic ifs.IVNic, onEnd WindowEndHandler) {
+	now := time.Now().Unix()
+	for _, status := range []l8events.MaintenanceStatus{
+		l8events.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED,
+		l8events.MaintenanceStatus_MAINTENANCE_STATUS_ACTIVE,
+	} {
+		windowsRaw, err := common.GetEntitiesByQuery(ServiceName, ServiceArea,
+			fmt.Sprintf("select * from MaintenanceWindow where Status=%d", status),
+			vnic,
+		)
+		if err != nil {
+			fmt.Printf("[maintenance] failed to query %s windows: %v\n", status.String(), err)
+			continue
+		}
+
+		for _, raw := range windowsRaw {
+			w := raw.(*alm.MaintenanceWindow)
+			next := lifecycleStatus(w, now)
+			if next == w.Status {
+				continue
+			}
+			wasActive := w.Status == l8events.MaintenanceStatus_MAINTENANCE_STATUS_ACTIVE
+			w.Status = next
+			w.UpdatedAt = now
+			if err := common.PutEntity(ServiceName, ServiceArea, w, vnic); err != nil {
+				fmt.Printf("[maintenance] failed to update window %s: %v\n", w.WindowId, err)
+				continue
+			}
+			if wasActive && onEnd != nil {
+				onEnd(w, vnic)
+			}
+		}
+	}
+}
+
+// lifecycleStatus returns the status the window should have at the given time.
+func lifecycleStatus(w *alm.MaintenanceWindow, now int64) l8events.MaintenanceStatus {
+	if isTimeActive(w, now) {
+		return l8events.MaintenanceStatus_MAINTENANCE_STATUS_ACTIVE
+	}
+	if !isRecurring(w) && now > w.EndTime {
+		return l8events.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
+	}
+	return l8events.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED
+}
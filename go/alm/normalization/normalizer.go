@@ -0,0 +1,138 @@
+package normalization
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/grok"
+	"github.com/saichler/l8alarms/go/alm/normalizationrules"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"regexp"
+	"sort"
+	"sync"
+)
+
+// maxCompiled bounds the compiled pattern cache; it is reset when full.
+const maxCompiled = 1024
+
+var (
+	compiledMtx sync.Mutex
+	compiled    = make(map[string]*regexp.Regexp)
+)
+
+var placeholder = regexp.MustCompile(`\$\{(\w+)\}`)
+
+// Normalize runs the active normalization rules over the event.
+func Normalize(event *alm.Event, vnic ifs.IVNic) error {
+	raw, err := common.GetEntitiesByQuery(normalizationrules.ServiceName, normalizationrules.ServiceArea,
+		fmt.Sprintf("select * from NormalizationRule where Status=%d", alm.AlmPolicyStatus_ALM_POLICY_STATUS_ACTIVE),
+		vnic,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to query normalization rules: %w", err)
+	}
+	rules := make([]*alm.NormalizationRule, 0, len(raw))
+	for _, r := range raw {
+		rules = append(rules, r.(*alm.NormalizationRule))
+	}
+	Apply(event, rules)
+	return nil
+}
+
+// Apply runs the rules over the event in ascending priority, then rule ID.
+// A rule whose pattern matches its field adds the named captures as
+// attributes (replacing attributes with the same key) and sets the
+// category, subcategory, node_name and severity it defines. A matching
+// rule with stop set ends the run.
+func Apply(event *alm.Event, rules []*alm.NormalizationRule) {
+	sorted := make([]*alm.NormalizationRule, len(rules))
+	copy(sorted, rules)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority < sorted[j].Priority
+		}
+		return sorted[i].RuleId < sorted[j].RuleId
+	})
+
+	for _, rule := range sorted {
+		if rule.EventTypeFilter != alm.AlmEventType_ALM_EVENT_TYPE_UNSPECIFIED &&
+			rule.EventTypeFilter != event.EventType {
+			continue
+		}
+		re, err := compile(rule.Pattern)
+		if err != nil {
+			continue
+		}
+		captures := grok.Captures(re, fieldValue(event, rule.Field))
+		if captures == nil {
+			continue
+		}
+
+		names := make([]string, 0, len(captures))
+		for name := range captures {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			setAttribute(event, name, captures[name])
+		}
+		if rule.Category != "" {
+			event.Category = expand(rule.Category, captures)
+		}
+		if rule.Subcategory != "" {
+			event.Subcategory = expand(rule.Subcategory, captures)
+		}
+		if rule.NodeName != "" {
+			event.NodeName = expand(rule.NodeName, captures)
+		}
+		if rule.Severity != l8events.Severity_SEVERITY_UNSPECIFIED {
+			event.Severity = rule.Severity
+		}
+		if rule.Stop {
+			return
+		}
+	}
+}
+
+func fieldValue(event *alm.Event, field alm.NormalizationField) string {
+	if field == alm.NormalizationField_NORMALIZATION_FIELD_RAW_DATA {
+		return event.RawData
+	}
+	return event.Message
+}
+
+func setAttribute(event *alm.Event, key, value string) {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			attr.Value = value
+			return
+		}
+	}
+	event.Attributes = append(event.Attributes, &alm.EventAttribute{Key: key, Value: value})
+}
+
+// expand substitutes the ${name} placeholders of a template with captures;
+// unknown names become "".
+func expand(template string, captures map[string]string) string {
+	return placeholder.ReplaceAllStringFunc(template, func(ref string) string {
+		return captures[placeholder.FindStringSubmatch(ref)[1]]
+	})
+}
+
+func compile(pattern string) (*regexp.Regexp, error) {
+	compiledMtx.Lock()
+	defer compiledMtx.Unlock()
+	if re, ok := compiled[pattern]; ok {
+		return re, nil
+	}
+	re, err := grok.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(compiled) >= maxCompiled {
+		compiled = make(map[string]*regexp.Regexp)
+	}
+	compiled[pattern] = re
+	return re, nil
+}
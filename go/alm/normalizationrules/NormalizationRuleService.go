@@ -0,0 +1,31 @@
+package normalizationrules
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+const (
+	ServiceName = "NormRule"
+	ServiceArea = byte(10)
+)
+
+func Activate(creds, dbname string, vnic ifs.IVNic) {
+	common.ActivateService(common.ServiceConfig{
+		ServiceName: ServiceName, ServiceArea: ServiceArea,
+		PrimaryKey: "RuleId", Callback: newNormalizationRuleServiceCallback(vnic),
+	}, &alm.NormalizationRule{}, &alm.NormalizationRuleList{}, creds, dbname, vnic)
+}
+
+func NormalizationRules(vnic ifs.IVNic) (ifs.IServiceHandler, bool) {
+	return common.ServiceHandler(ServiceName, ServiceArea, vnic)
+}
+
+func NormalizationRule(id string, vnic ifs.IVNic) (*alm.NormalizationRule, error) {
+	result, err := common.GetEntity(ServiceName, ServiceArea, &alm.NormalizationRule{RuleId: id}, vnic)
+	if err != nil || result == nil {
+		return nil, err
+	}
+	return result.(*alm.NormalizationRule), nil
+}
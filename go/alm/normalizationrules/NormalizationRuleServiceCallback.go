@@ -0,0 +1,35 @@
+package normalizationrules
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/grok"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+)
+
+func newNormalizationRuleServiceCallback(vnic ifs.IVNic) ifs.IServiceCallback {
+	return common.NewValidation(&alm.NormalizationRule{}, vnic).
+		Require(func(e interface{}) string { return e.(*alm.NormalizationRule).RuleId }, "RuleId").
+		Require(func(e interface{}) string { return e.(*alm.NormalizationRule).Name }, "Name").
+		Require(func(e interface{}) string { return e.(*alm.NormalizationRule).Pattern }, "Pattern").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.NormalizationRule).Status) }, alm.AlmPolicyStatus_name, "Status").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.NormalizationRule).EventTypeFilter) }, alm.AlmEventType_name, "EventTypeFilter").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.NormalizationRule).Field) }, alm.NormalizationField_name, "Field").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.NormalizationRule).Severity) }, l8events.Severity_name, "Severity").
+		BeforeAction(validatePattern).
+		Build()
+}
+
+// validatePattern rejects rules whose pattern does not compile. A PATCH
+// without the pattern leaves it unchanged.
+func validatePattern(rule *alm.NormalizationRule, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST && action != ifs.PUT && action != ifs.PATCH {
+		return nil
+	}
+	if _, err := grok.Compile(rule.Pattern); err != nil {
+		return fmt.Errorf("invalid pattern: %v", err)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package notification
 
 import (
 	"fmt"
+	"github.com/saichler/l8alarms/go/alm/conditions"
 	"github.com/saichler/l8alarms/go/alm/notificationpolicies"
 	"github.com/saichler/l8alarms/go/types/alm"
 	"github.com/saichler/l8common/go/common"
@@ -30,6 +31,23 @@ func (e *Engine) Notify(alarm *alm.Alarm, action ifs.Action, suppressNotificatio
 		return
 	}
 
+	isStateChange := action == ifs.PUT || action == ifs.PATCH
+	e.notify(alarm, vnic, func(policy *alm.NotificationPolicy) bool {
+		return matchesPolicy(alarm, policy, isStateChange)
+	})
+}
+
+// NotifyReleased notifies an alarm that was suppressed when it was raised and
+// has just become ACTIVE, as if it were newly raised. Policies that notify on
+// state changes are skipped, as the state change itself already triggers them.
+func (e *Engine) NotifyReleased(alarm *alm.Alarm, vnic ifs.IVNic) {
+	e.notify(alarm, vnic, func(policy *alm.NotificationPolicy) bool {
+		return !policy.NotifyOnStateChange && matchesPolicy(alarm, policy, false)
+	})
+}
+
+// notify dispatches the alarm to every active policy accepted by matches, subject to throttling.
+func (e *Engine) notify(alarm *alm.Alarm, vnic ifs.IVNic, matches func(*alm.NotificationPolicy) bool) {
 	policiesRaw, err := common.GetEntitiesByQuery(
 		notificationpolicies.ServiceName, notificationpolicies.ServiceArea,
 		fmt.Sprintf("select * from NotificationPolicy where Status=%d",
@@ -40,11 +58,9 @@ func (e *Engine) Notify(alarm *alm.Alarm, action ifs.Action, suppressNotificatio
 		return
 	}
 
-	isStateChange := action == ifs.PUT || action == ifs.PATCH
-
 	for _, raw := range policiesRaw {
 		policy := raw.(*alm.NotificationPolicy)
-		if !matchesPolicy(alarm, policy, isStateChange) {
+		if !matches(policy) {
 			continue
 		}
 		key := alarm.AlarmId + ":" + policy.PolicyId
@@ -78,8 +94,8 @@ func matchesPolicy(alarm *alm.Alarm, policy *alm.NotificationPolicy, isStateChan
 		}
 	}
 	if len(policy.NodeTypeFilter) > 0 {
-		nodeType, ok := alarm.Attributes["nodeType"]
-		if !ok {
+		nodeType, _ := conditions.AlarmField(alarm, "attributes.nodeType")
+		if nodeType == "" {
 			return false
 		}
 		found := false
@@ -93,7 +109,7 @@ func matchesPolicy(alarm *alm.Alarm, policy *alm.NotificationPolicy, isStateChan
 			return false
 		}
 	}
-	return true
+	return conditions.MatchAll(alarm, policy.Conditions)
 }
 
 // dispatch sends notifications to all targets of a policy using l8notify.
@@ -0,0 +1,211 @@
+package processing
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/alarmdefinitions"
+	"github.com/saichler/l8alarms/go/alm/alarms"
+	"github.com/saichler/l8alarms/go/alm/autoclear"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/alm/dedup"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8common "github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"time"
+)
+
+// Engine turns incoming events into alarms, driven by the ACTIVE alarm definitions.
+type Engine struct {
+	thresholds *thresholdCounter
+}
+
+// NewEngine creates a new event processing engine. The history is used to
+// rebuild threshold counters from previously processed events.
+func NewEngine(history EventHistory) *Engine {
+	return &Engine{thresholds: newThresholdCounter(history)}
+}
+
+// Process matches the event against active alarm definitions and raises a new
+// alarm or updates the matching open alarm: the one with the same dedup key,
+// or with dedup disabled, the one of the same node and source identifier. An event matching a definition's
+// clear_event_pattern clears the open alarm instead. A new alarm is only raised once the
+// definition's threshold is reached; events below the threshold are marked
+// PROCESSED without an alarm_id. The outcome is recorded on the event
+// (alarm_id, definition_id, processed_at, processing_state); persisting the
+// event is left to the caller.
+func (e *Engine) Process(event *alm.Event, vnic ifs.IVNic) error {
+	defsRaw, err := l8common.GetEntitiesByQuery(
+		alarmdefinitions.ServiceName, alarmdefinitions.ServiceArea,
+		fmt.Sprintf("select * from AlarmDefinition where Status=%d",
+			alm.AlarmDefinitionStatus_ALARM_DEFINITION_STATUS_ACTIVE),
+		vnic,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to query alarm definitions: %w", err)
+	}
+	definitions := make([]*alm.AlarmDefinition, 0, len(defsRaw))
+	for _, d := range defsRaw {
+		definitions = append(definitions, d.(*alm.AlarmDefinition))
+	}
+
+	now := time.Now().Unix()
+	event.ProcessedAt = now
+
+	clearDef, cleared, err := autoclear.ClearByEvent(event, definitions, vnic)
+	if err != nil {
+		return err
+	}
+	if len(cleared) > 0 {
+		e.thresholds.reset(event, clearDef, dedup.EventKey(clearDef, event))
+		event.DefinitionId = clearDef.DefinitionId
+		event.AlarmId = cleared[0].AlarmId
+		event.ProcessingState = l8events.EventState_EVENT_STATE_PROCESSED
+		return nil
+	}
+
+	def := matchDefinition(event, definitions)
+	if def == nil {
+		event.ProcessingState = l8events.EventState_EVENT_STATE_DISCARDED
+		return nil
+	}
+	event.DefinitionId = def.DefinitionId
+
+	alarm, err := e.raiseOrUpdate(event, def, vnic)
+	if err != nil {
+		return err
+	}
+	if alarm != nil {
+		event.AlarmId = alarm.AlarmId
+	}
+	event.ProcessingState = l8events.EventState_EVENT_STATE_PROCESSED
+	return nil
+}
+
+// raiseOrUpdate folds the event into its open alarm, or creates a new alarm
+// if there is none and the threshold is reached. It returns nil if the event
+// was only counted.
+func (e *Engine) raiseOrUpdate(event *alm.Event, def *alm.AlarmDefinition, vnic ifs.IVNic) (*alm.Alarm, error) {
+	key := dedup.EventKey(def, event)
+	existing, err := findOpenAlarm(def, event, key, vnic)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		// Without dedup settings a repeat occurrence escalates the severity
+		escalate := !def.DedupEnabled || def.DedupEscalateSeverity
+		dedup.RecordOccurrence(existing, eventTime(event), event.Severity, escalate)
+		if err := alarms.PutInternal(existing, vnic); err != nil {
+			return nil, fmt.Errorf("failed to update alarm %s: %w", existing.AlarmId, err)
+		}
+		return existing, nil
+	}
+
+	reached, err := e.thresholds.record(event, def, key, vnic)
+	if err != nil {
+		return nil, err
+	}
+	if !reached {
+		return nil, nil
+	}
+
+	alarm := newAlarm(event, def)
+	alarm.DedupKey = key
+	if err := postAlarm(alarm, vnic); err != nil {
+		return nil, fmt.Errorf("failed to raise alarm for event %s: %w", event.EventId, err)
+	}
+	return alarm, nil
+}
+
+// findOpenAlarm returns the non-cleared alarm of the definition an event
+// folds into, or nil if there is none: the alarm with the event's dedup key
+// or, with dedup disabled, the alarm of the same node and source identifier.
+func findOpenAlarm(def *alm.AlarmDefinition, event *alm.Event, dedupKey string, vnic ifs.IVNic) (*alm.Alarm, error) {
+	query := fmt.Sprintf("select * from Alarm where DefinitionId=%s and State!=%d",
+		def.DefinitionId, l8events.AlarmState_ALARM_STATE_CLEARED)
+	if def.DedupEnabled {
+		query = common.MatchQuery(query, "DedupKey", dedupKey)
+	} else {
+		query = common.MatchQuery(query, "NodeId", event.NodeId)
+		query = common.MatchQuery(query, "SourceIdentifier", event.SourceIdentifier)
+	}
+	alarmsRaw, err := l8common.GetEntitiesByQuery(alarms.ServiceName, alarms.ServiceArea, query, vnic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query open alarms: %w", err)
+	}
+	for _, raw := range alarmsRaw {
+		a := raw.(*alm.Alarm)
+		if a.State == l8events.AlarmState_ALARM_STATE_CLEARED {
+			continue
+		}
+		if def.DedupEnabled && a.DedupKey == dedupKey {
+			return a, nil
+		}
+		if !def.DedupEnabled && a.NodeId == event.NodeId && a.SourceIdentifier == event.SourceIdentifier {
+			return a, nil
+		}
+	}
+	return nil, nil
+}
+
+// newAlarm builds an ACTIVE alarm from a matching event and its definition.
+func newAlarm(event *alm.Event, def *alm.AlarmDefinition) *alm.Alarm {
+	severity := event.Severity
+	if severity == l8events.Severity_SEVERITY_UNSPECIFIED {
+		severity = def.DefaultSeverity
+	}
+	occurred := eventTime(event)
+
+	attributes := make(map[string]string, len(event.Attributes)+2)
+	for _, attr := range event.Attributes {
+		attributes[attr.Key] = attr.Value
+	}
+	if event.Category != "" {
+		attributes["category"] = event.Category
+	}
+	if event.Subcategory != "" {
+		attributes["subcategory"] = event.Subcategory
+	}
+
+	return &alm.Alarm{
+		AlarmId:          ifs.NewUuid(),
+		DefinitionId:     def.DefinitionId,
+		Name:             def.Name,
+		Description:      def.Description,
+		State:            l8events.AlarmState_ALARM_STATE_ACTIVE,
+		Severity:         severity,
+		OriginalSeverity: severity,
+		NodeId:           event.NodeId,
+		NodeName:         event.NodeName,
+		Location:         attributes["location"],
+		SourceIdentifier: event.SourceIdentifier,
+		FirstOccurrence:  occurred,
+		LastOccurrence:   occurred,
+		OccurrenceCount:  1,
+		EventId:          event.EventId,
+		Attributes:       attributes,
+	}
+}
+
+// eventTime returns when the event occurred, falling back to when it was received.
+func eventTime(event *alm.Event) int64 {
+	if event.OccurredAt != 0 {
+		return event.OccurredAt
+	}
+	if event.ReceivedAt != 0 {
+		return event.ReceivedAt
+	}
+	return time.Now().Unix()
+}
+
+func postAlarm(alarm *alm.Alarm, vnic ifs.IVNic) error {
+	handler, ok := alarms.Alarms(vnic)
+	if !ok {
+		return fmt.Errorf("Alarm service not available")
+	}
+	resp := handler.Post(object.New(nil, alarm), vnic)
+	if resp != nil && resp.Error() != nil {
+		return resp.Error()
+	}
+	return nil
+}
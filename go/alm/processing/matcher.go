@@ -0,0 +1,74 @@
+package processing
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	"regexp"
+	"sort"
+)
+
+// matchDefinition returns the first definition (ordered by DefinitionId) whose
+// event filters all match the event, or nil if none match.
+func matchDefinition(event *alm.Event, definitions []*alm.AlarmDefinition) *alm.AlarmDefinition {
+	sorted := make([]*alm.AlarmDefinition, len(definitions))
+	copy(sorted, definitions)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].DefinitionId < sorted[j].DefinitionId
+	})
+
+	for _, def := range sorted {
+		if matchesDefinition(event, def) {
+			return def
+		}
+	}
+	return nil
+}
+
+// matchesDefinition checks the event against a definition's event_pattern,
+// event_type_filter, node_type_filter and node_type_scope.
+// Definitions without an event_pattern are not event-driven and never match.
+func matchesDefinition(event *alm.Event, def *alm.AlarmDefinition) bool {
+	if def.Status != alm.AlarmDefinitionStatus_ALARM_DEFINITION_STATUS_ACTIVE {
+		return false
+	}
+	if def.EventPattern == "" {
+		return false
+	}
+
+	if def.EventTypeFilter != alm.AlmEventType_ALM_EVENT_TYPE_UNSPECIFIED &&
+		def.EventTypeFilter != event.EventType {
+		return false
+	}
+
+	nodeType := eventAttribute(event, "nodeType")
+	if def.NodeTypeFilter != "" && def.NodeTypeFilter != nodeType {
+		return false
+	}
+	if len(def.NodeTypeScope) > 0 && !stringInSlice(nodeType, def.NodeTypeScope) {
+		return false
+	}
+
+	pattern, err := regexp.Compile(def.EventPattern)
+	if err != nil {
+		return false
+	}
+	return pattern.MatchString(event.Message)
+}
+
+// eventAttribute returns the value of the named event attribute, or "" if absent.
+func eventAttribute(event *alm.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func stringInSlice(s string, list []string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
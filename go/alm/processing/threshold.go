@@ -0,0 +1,125 @@
+package processing
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/dedup"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8types/go/ifs"
+	"sort"
+	"sync"
+)
+
+// EventHistory returns the events previously matched to a definition on a node
+// that occurred at or after since, or all of them when since is 0.
+// It is used to rebuild threshold counters, e.g. after a service restart.
+type EventHistory func(definitionId, nodeId string, since int64, vnic ifs.IVNic) ([]*alm.Event, error)
+
+// thresholdCounter is a sliding-window counter of matching events, keyed by
+// definition + node + dedup key. Counters are rebuilt lazily from the event
+// history the first time a key is seen.
+type thresholdCounter struct {
+	mtx         sync.Mutex
+	history     EventHistory
+	occurrences map[string][]int64
+}
+
+func newThresholdCounter(history EventHistory) *thresholdCounter {
+	return &thresholdCounter{
+		history:     history,
+		occurrences: make(map[string][]int64),
+	}
+}
+
+// record counts the event against its definition's threshold and reports
+// whether threshold_count occurrences have been seen within
+// threshold_window_seconds. Once reached, the counter for the key is reset.
+// Definitions with a threshold_count of 0 or 1 raise on the first event.
+// A threshold_window_seconds of 0 counts occurrences without expiry.
+func (c *thresholdCounter) record(event *alm.Event, def *alm.AlarmDefinition, key string, vnic ifs.IVNic) (bool, error) {
+	if def.ThresholdCount <= 1 {
+		return true, nil
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	counterKey := thresholdKey(event, def, key)
+	times, ok := c.occurrences[counterKey]
+	if !ok {
+		rebuilt, err := c.rebuild(event, def, key, vnic)
+		if err != nil {
+			return false, err
+		}
+		times = rebuilt
+	}
+
+	now := eventTime(event)
+	times = pruneWindow(append(times, now), now, def.ThresholdWindowSeconds)
+
+	if int32(len(times)) >= def.ThresholdCount {
+		c.occurrences[counterKey] = nil
+		return true, nil
+	}
+	c.occurrences[counterKey] = times
+	return false, nil
+}
+
+// reset drops the pending occurrences for a key, e.g. when its alarm is cleared.
+func (c *thresholdCounter) reset(event *alm.Event, def *alm.AlarmDefinition, key string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.occurrences[thresholdKey(event, def, key)] = nil
+}
+
+// rebuild recovers the pending occurrences for a key from the event history.
+// Only events after the last one that raised or updated an alarm are pending;
+// the event being processed is excluded.
+func (c *thresholdCounter) rebuild(event *alm.Event, def *alm.AlarmDefinition, key string, vnic ifs.IVNic) ([]int64, error) {
+	if c.history == nil {
+		return nil, nil
+	}
+	var since int64
+	if def.ThresholdWindowSeconds > 0 {
+		since = eventTime(event) - int64(def.ThresholdWindowSeconds)
+	}
+	history, err := c.history(def.DefinitionId, event.NodeId, since, vnic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild threshold counter for definition %s: %w", def.DefinitionId, err)
+	}
+
+	sort.Slice(history, func(i, j int) bool {
+		return eventTime(history[i]) < eventTime(history[j])
+	})
+
+	var times []int64
+	for _, h := range history {
+		if h.EventId == event.EventId || dedup.EventKey(def, h) != key {
+			continue
+		}
+		if h.AlarmId != "" {
+			times = nil
+			continue
+		}
+		times = append(times, eventTime(h))
+	}
+	return pruneWindow(times, eventTime(event), def.ThresholdWindowSeconds), nil
+}
+
+// pruneWindow drops occurrences older than the window ending at now.
+func pruneWindow(times []int64, now int64, windowSeconds int32) []int64 {
+	if windowSeconds <= 0 {
+		return times
+	}
+	cutoff := now - int64(windowSeconds)
+	kept := times[:0]
+	for _, t := range times {
+		if t > cutoff {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
+
+func thresholdKey(event *alm.Event, def *alm.AlarmDefinition, key string) string {
+	return def.DefinitionId + "|" + event.NodeId + "|" + key
+}
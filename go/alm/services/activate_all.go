@@ -1,17 +1,31 @@
 package services
 
 import (
+	"github.com/saichler/l8alarms/go/alm/alarmactions"
 	"github.com/saichler/l8alarms/go/alm/alarmdefinitions"
 	"github.com/saichler/l8alarms/go/alm/alarmfilters"
 	"github.com/saichler/l8alarms/go/alm/alarms"
 	"github.com/saichler/l8alarms/go/alm/archivedalarms"
 	"github.com/saichler/l8alarms/go/alm/archivedevents"
+	"github.com/saichler/l8alarms/go/alm/archiveexport"
+	"github.com/saichler/l8alarms/go/alm/archivepolicies"
+	"github.com/saichler/l8alarms/go/alm/archiving"
+	"github.com/saichler/l8alarms/go/alm/autoclear"
+	"github.com/saichler/l8alarms/go/alm/common"
 	"github.com/saichler/l8alarms/go/alm/correlationrules"
 	"github.com/saichler/l8alarms/go/alm/enrichment"
 	"github.com/saichler/l8alarms/go/alm/escalationpolicies"
+	"github.com/saichler/l8alarms/go/alm/escalationstates"
 	"github.com/saichler/l8alarms/go/alm/events"
+	"github.com/saichler/l8alarms/go/alm/heartbeatmonitors"
 	"github.com/saichler/l8alarms/go/alm/maintenancewindows"
+	"github.com/saichler/l8alarms/go/alm/normalizationrules"
 	"github.com/saichler/l8alarms/go/alm/notificationpolicies"
+	"github.com/saichler/l8alarms/go/alm/snmptrap"
+	"github.com/saichler/l8alarms/go/alm/syslog"
+	"github.com/saichler/l8alarms/go/alm/trapmappings"
+	"github.com/saichler/l8alarms/go/alm/webhook"
+	"github.com/saichler/l8alarms/go/alm/webhookmappings"
 	"github.com/saichler/l8types/go/ifs"
 )
 
@@ -20,6 +34,13 @@ func ActivateAlmServices(creds, dbname string, vnic ifs.IVNic) {
 	alarmdefinitions.Activate(creds, dbname, vnic)
 	alarms.Activate(creds, dbname, vnic)
 	events.Activate(creds, dbname, vnic)
+	alarmactions.Activate(vnic)
+
+	// Ingestion
+	trapmappings.Activate(creds, dbname, vnic)
+	webhookmappings.Activate(creds, dbname, vnic)
+	heartbeatmonitors.Activate(creds, dbname, vnic)
+	normalizationrules.Activate(creds, dbname, vnic)
 
 	// Correlation
 	correlationrules.Activate(creds, dbname, vnic)
@@ -27,6 +48,7 @@ func ActivateAlmServices(creds, dbname string, vnic ifs.IVNic) {
 	// Policies
 	notificationpolicies.Activate(creds, dbname, vnic)
 	escalationpolicies.Activate(creds, dbname, vnic)
+	escalationstates.Activate(creds, dbname, vnic)
 
 	// Operations
 	maintenancewindows.Activate(creds, dbname, vnic)
@@ -35,7 +57,23 @@ func ActivateAlmServices(creds, dbname string, vnic ifs.IVNic) {
 	// Archive
 	archivedalarms.Activate(creds, dbname, vnic)
 	archivedevents.Activate(creds, dbname, vnic)
+	archivepolicies.Activate(creds, dbname, vnic)
+	archiving.Activate(vnic)
+	archiveexport.Activate(vnic)
 
 	// Topology enrichment (read-only, no DB)
 	enrichment.Activate(vnic)
+
+	// Background schedulers
+	maintenancewindows.StartScheduler(vnic, alarms.ReleaseSuppressed)
+	autoclear.Activate(vnic)
+	alarms.ResumeEscalations(vnic)
+	archiving.StartRetention(vnic, common.ARCHIVE_RETENTION_SECONDS)
+	archiving.StartPurger(vnic)
+	events.StartHeartbeatTracker(vnic)
+
+	// Event receivers
+	snmptrap.Activate(vnic)
+	syslog.Activate(vnic)
+	webhook.Activate(vnic)
 }
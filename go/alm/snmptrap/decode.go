@@ -0,0 +1,165 @@
+package snmptrap
+
+import (
+	"fmt"
+	"github.com/gosnmp/gosnmp"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"net"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// snmpTrapOID is the varbind carrying the trap OID of SNMPv2c/v3 traps.
+	snmpTrapOID = ".1.3.6.1.6.3.1.1.4.1.0"
+	// snmpTraps is the prefix of the generic traps (coldStart, linkDown, ...).
+	snmpTraps = ".1.3.6.1.6.3.1.1.5"
+	// enterpriseSpecific is the SNMPv1 generic-trap value of enterprise traps.
+	enterpriseSpecific = 6
+
+	// trapCategory classifies traps that no mapping matches.
+	trapCategory = "snmp-trap"
+)
+
+// trapOid returns the trap OID of a trap. SNMPv1 traps are translated as in
+// RFC 3584: generic traps to snmpTraps.<generic+1>, enterprise traps to
+// <enterprise>.0.<specific>.
+func trapOid(packet *gosnmp.SnmpPacket) string {
+	if packet.Version == gosnmp.Version1 {
+		if packet.GenericTrap == enterpriseSpecific {
+			return normalizeOid(packet.Enterprise) + ".0." + strconv.Itoa(packet.SpecificTrap)
+		}
+		return snmpTraps + "." + strconv.Itoa(packet.GenericTrap+1)
+	}
+	for _, v := range packet.Variables {
+		if normalizeOid(v.Name) == snmpTrapOID {
+			if oid, ok := v.Value.(string); ok {
+				return normalizeOid(oid)
+			}
+		}
+	}
+	return ""
+}
+
+// agentAddress returns the address of the agent that raised the trap: the
+// agent-addr of SNMPv1 traps, which survives forwarding, or else the sender.
+func agentAddress(packet *gosnmp.SnmpPacket, addr *net.UDPAddr) string {
+	if packet.Version == gosnmp.Version1 && packet.AgentAddress != "" && packet.AgentAddress != "0.0.0.0" {
+		return packet.AgentAddress
+	}
+	if addr == nil {
+		return ""
+	}
+	return addr.IP.String()
+}
+
+// toEvent builds the TRAP event of a trap. Every varbind becomes an
+// attribute keyed by its OID, and raw_data holds a readable dump of the trap.
+func toEvent(packet *gosnmp.SnmpPacket, agent, oid string, mapping *alm.TrapMapping) *alm.Event {
+	event := &alm.Event{
+		EventType:        alm.AlmEventType_ALM_EVENT_TYPE_TRAP,
+		NodeName:         agent,
+		SourceIdentifier: oid,
+		Severity:         l8events.Severity_SEVERITY_INFO,
+		Message:          "SNMP trap " + oid,
+		Category:         trapCategory,
+		RawData:          dump(packet, agent, oid),
+	}
+	if mapping != nil {
+		if mapping.Severity != l8events.Severity_SEVERITY_UNSPECIFIED {
+			event.Severity = mapping.Severity
+		}
+		if mapping.Category != "" {
+			event.Category = mapping.Category
+		}
+		event.Subcategory = mapping.Subcategory
+		if mapping.Message != "" {
+			event.Message = mapping.Message
+		}
+	}
+
+	event.Attributes = append(event.Attributes,
+		&alm.EventAttribute{Key: "snmpVersion", Value: versionName(packet.Version)},
+		&alm.EventAttribute{Key: "agentAddress", Value: agent},
+		&alm.EventAttribute{Key: "trapOid", Value: oid},
+	)
+	for _, v := range packet.Variables {
+		event.Attributes = append(event.Attributes, &alm.EventAttribute{Key: normalizeOid(v.Name), Value: varbindValue(v)})
+	}
+	return event
+}
+
+// dump renders a trap the way snmptrapd logs it, one varbind per line.
+func dump(packet *gosnmp.SnmpPacket, agent, oid string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s from %s\n", versionName(packet.Version), pduName(packet), agent)
+	fmt.Fprintf(&b, "trap OID: %s\n", oid)
+	if packet.Version == gosnmp.Version1 {
+		fmt.Fprintf(&b, "enterprise: %s generic: %d specific: %d uptime: %d\n",
+			normalizeOid(packet.Enterprise), packet.GenericTrap, packet.SpecificTrap, packet.Timestamp)
+	}
+	for _, v := range packet.Variables {
+		fmt.Fprintf(&b, "%s = %s: %s\n", normalizeOid(v.Name), v.Type.String(), varbindValue(v))
+	}
+	return b.String()
+}
+
+// varbindValue renders a varbind value as text. Octet strings are shown as
+// text when printable and as colon-separated hex otherwise.
+func varbindValue(v gosnmp.SnmpPDU) string {
+	switch v.Type {
+	case gosnmp.OctetString:
+		b, _ := v.Value.([]byte)
+		if printable(b) {
+			return string(b)
+		}
+		hex := make([]string, len(b))
+		for i, c := range b {
+			hex[i] = fmt.Sprintf("%02x", c)
+		}
+		return strings.Join(hex, ":")
+	case gosnmp.ObjectIdentifier:
+		s, _ := v.Value.(string)
+		return normalizeOid(s)
+	case gosnmp.Null, gosnmp.NoSuchObject, gosnmp.NoSuchInstance, gosnmp.EndOfMibView:
+		return ""
+	}
+	return fmt.Sprint(v.Value)
+}
+
+func printable(b []byte) bool {
+	for _, r := range string(b) {
+		if r == unicode.ReplacementChar || (!unicode.IsPrint(r) && !unicode.IsSpace(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeOid(oid string) string {
+	if oid == "" || strings.HasPrefix(oid, ".") {
+		return oid
+	}
+	return "." + oid
+}
+
+func versionName(v gosnmp.SnmpVersion) string {
+	switch v {
+	case gosnmp.Version1:
+		return "SNMPv1"
+	case gosnmp.Version2c:
+		return "SNMPv2c"
+	case gosnmp.Version3:
+		return "SNMPv3"
+	}
+	return v.String()
+}
+
+func pduName(packet *gosnmp.SnmpPacket) string {
+	if packet.PDUType == gosnmp.InformRequest {
+		return "inform"
+	}
+	return "trap"
+}
@@ -0,0 +1,66 @@
+package snmptrap
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/trapmappings"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	"strings"
+	"sync"
+	"time"
+)
+
+// mappingRefreshInterval is how long the active trap mappings are cached
+// before they are queried again.
+const mappingRefreshInterval = 30 * time.Second
+
+// mappingCache holds the active trap mappings, so that a trap storm does not
+// query the TrapMapping service once per trap.
+type mappingCache struct {
+	mu          sync.Mutex
+	mappings    []*alm.TrapMapping
+	refreshedAt time.Time
+}
+
+func newMappingCache() *mappingCache {
+	return &mappingCache{}
+}
+
+// match returns the active mapping with the longest trap OID that equals, or
+// is an ancestor of, the trap OID, or nil if none matches.
+func (c *mappingCache) match(oid string, vnic ifs.IVNic) *alm.TrapMapping {
+	var best *alm.TrapMapping
+	for _, m := range c.active(vnic) {
+		if m.TrapOid != oid && !strings.HasPrefix(oid, m.TrapOid+".") {
+			continue
+		}
+		if best == nil || len(m.TrapOid) > len(best.TrapOid) {
+			best = m
+		}
+	}
+	return best
+}
+
+func (c *mappingCache) active(vnic ifs.IVNic) []*alm.TrapMapping {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Since(c.refreshedAt) < mappingRefreshInterval {
+		return c.mappings
+	}
+
+	mappingsRaw, err := common.GetEntitiesByQuery(trapmappings.ServiceName, trapmappings.ServiceArea,
+		fmt.Sprintf("select * from TrapMapping where Status=%d", alm.AlmPolicyStatus_ALM_POLICY_STATUS_ACTIVE),
+		vnic,
+	)
+	if err != nil {
+		fmt.Printf("[snmptrap] failed to query trap mappings: %v\n", err)
+		return c.mappings
+	}
+	c.mappings = make([]*alm.TrapMapping, 0, len(mappingsRaw))
+	for _, raw := range mappingsRaw {
+		c.mappings = append(c.mappings, raw.(*alm.TrapMapping))
+	}
+	c.refreshedAt = time.Now()
+	return c.mappings
+}
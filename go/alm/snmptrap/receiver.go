@@ -0,0 +1,127 @@
+package snmptrap
+
+import (
+	"fmt"
+	"github.com/gosnmp/gosnmp"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/alm/ingest"
+	"github.com/saichler/l8types/go/ifs"
+	"net"
+	"time"
+)
+
+// listenTimeout bounds how long Start waits for the UDP socket to be bound.
+const listenTimeout = 5 * time.Second
+
+// Config configures a trap Receiver.
+type Config struct {
+	// Address is the UDP address to listen on, e.g. "0.0.0.0:162".
+	Address string
+	// Communities accepted on SNMPv1/v2c traps; empty drops every SNMPv1/v2c trap.
+	Communities []string
+	// Users accepted on SNMPv3 traps; empty drops every SNMPv3 trap.
+	Users []common.SnmpV3User
+}
+
+// Receiver listens for SNMPv1/v2c/v3 traps and informs, and posts each one
+// as an ALM_EVENT_TYPE_TRAP event. Informs are acknowledged by the listener.
+type Receiver struct {
+	listener    *gosnmp.TrapListener
+	communities map[string]bool
+	acceptV3    bool
+	mappings    *mappingCache
+	resolver    *ingest.NodeResolver
+	vnic        ifs.IVNic
+}
+
+// Activate starts the trap receiver on common.SNMP_TRAP_ADDRESS, unless it is empty.
+func Activate(vnic ifs.IVNic) {
+	if common.SNMP_TRAP_ADDRESS == "" {
+		return
+	}
+	_, err := Start(Config{
+		Address:     common.SNMP_TRAP_ADDRESS,
+		Communities: common.SNMP_TRAP_COMMUNITIES,
+		Users:       common.SNMP_TRAP_V3_USERS,
+	}, vnic)
+	if err != nil {
+		fmt.Printf("[snmptrap] failed to start trap receiver on %s: %v\n", common.SNMP_TRAP_ADDRESS, err)
+		return
+	}
+	if len(common.SNMP_TRAP_COMMUNITIES) == 0 && len(common.SNMP_TRAP_V3_USERS) == 0 {
+		fmt.Printf("[snmptrap] no SNMP_TRAP_COMMUNITIES or SNMP_TRAP_V3_USERS, every trap is dropped\n")
+	}
+}
+
+// Start binds the trap receiver and returns once it is listening.
+func Start(cfg Config, vnic ifs.IVNic) (*Receiver, error) {
+	params := &gosnmp.GoSNMP{Version: gosnmp.Version2c}
+	if len(cfg.Users) > 0 {
+		table, err := securityTable(cfg.Users, params.Logger)
+		if err != nil {
+			return nil, err
+		}
+		// gosnmp only authenticates SNMPv3 traps when the listener itself is
+		// v3; SNMPv1/v2c traps are still decoded by their own version.
+		params.Version = gosnmp.Version3
+		params.TrapSecurityParametersTable = table
+	}
+
+	r := &Receiver{
+		listener:    gosnmp.NewTrapListener(),
+		communities: make(map[string]bool),
+		acceptV3:    len(cfg.Users) > 0,
+		mappings:    newMappingCache(),
+		resolver:    ingest.NewNodeResolver(),
+		vnic:        vnic,
+	}
+	for _, c := range cfg.Communities {
+		r.communities[c] = true
+	}
+	r.listener.Params = params
+	r.listener.OnNewTrap = r.onTrap
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- r.listener.Listen(cfg.Address)
+	}()
+	select {
+	case <-r.listener.Listening():
+		fmt.Printf("[snmptrap] listening on %s\n", cfg.Address)
+		return r, nil
+	case err := <-errCh:
+		return nil, err
+	case <-time.After(listenTimeout):
+		r.listener.Close()
+		return nil, fmt.Errorf("timed out binding %s", cfg.Address)
+	}
+}
+
+// Close stops the receiver.
+func (r *Receiver) Close() {
+	r.listener.Close()
+}
+
+func (r *Receiver) onTrap(packet *gosnmp.SnmpPacket, addr *net.UDPAddr) {
+	if !r.accept(packet) {
+		return
+	}
+	agent := agentAddress(packet, addr)
+	oid := trapOid(packet)
+	mapping := r.mappings.match(oid, r.vnic)
+	event := toEvent(packet, agent, oid, mapping)
+	event.NodeId = r.resolver.Resolve(agent, r.vnic)
+	if err := ingest.PostEvent(event, r.vnic); err != nil {
+		fmt.Printf("[snmptrap] failed to post trap %s from %s: %v\n", oid, agent, err)
+	}
+}
+
+// accept drops SNMPv1/v2c traps unless their community is configured, and
+// SNMPv3 traps unless USM users are configured. SNMPv3 traps of unknown users, or failing
+// authentication, are already dropped by the listener.
+func (r *Receiver) accept(packet *gosnmp.SnmpPacket) bool {
+	if packet.Version == gosnmp.Version3 {
+		return r.acceptV3
+	}
+	return r.communities[packet.Community]
+}
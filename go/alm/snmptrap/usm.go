@@ -0,0 +1,65 @@
+package snmptrap
+
+import (
+	"fmt"
+	"github.com/gosnmp/gosnmp"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"strings"
+)
+
+// securityTable builds the USM table the listener authenticates and decrypts
+// SNMPv3 traps with, keyed by user name.
+func securityTable(users []common.SnmpV3User, logger gosnmp.Logger) (*gosnmp.SnmpV3SecurityParametersTable, error) {
+	table := gosnmp.NewSnmpV3SecurityParametersTable(logger)
+	for _, u := range users {
+		if u.UserName == "" {
+			return nil, fmt.Errorf("SNMPv3 user without a user name")
+		}
+		auth, err := authProtocol(u.AuthProtocol)
+		if err != nil {
+			return nil, fmt.Errorf("SNMPv3 user %s: %w", u.UserName, err)
+		}
+		priv, err := privProtocol(u.PrivProtocol)
+		if err != nil {
+			return nil, fmt.Errorf("SNMPv3 user %s: %w", u.UserName, err)
+		}
+		if auth == gosnmp.NoAuth && priv != gosnmp.NoPriv {
+			return nil, fmt.Errorf("SNMPv3 user %s: privacy requires authentication", u.UserName)
+		}
+		sp := &gosnmp.UsmSecurityParameters{
+			UserName:                 u.UserName,
+			AuthenticationProtocol:   auth,
+			AuthenticationPassphrase: u.AuthPassphrase,
+			PrivacyProtocol:          priv,
+			PrivacyPassphrase:        u.PrivPassphrase,
+		}
+		if err := table.Add(u.UserName, sp); err != nil {
+			return nil, fmt.Errorf("SNMPv3 user %s: %w", u.UserName, err)
+		}
+	}
+	return table, nil
+}
+
+func authProtocol(name string) (gosnmp.SnmpV3AuthProtocol, error) {
+	if name == "" {
+		return gosnmp.NoAuth, nil
+	}
+	for p := gosnmp.NoAuth; p <= gosnmp.SHA512; p++ {
+		if strings.EqualFold(p.String(), name) {
+			return p, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown authentication protocol %q", name)
+}
+
+func privProtocol(name string) (gosnmp.SnmpV3PrivProtocol, error) {
+	if name == "" {
+		return gosnmp.NoPriv, nil
+	}
+	for p := gosnmp.NoPriv; p <= gosnmp.AES256C; p++ {
+		if strings.EqualFold(p.String(), name) {
+			return p, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown privacy protocol %q", name)
+}
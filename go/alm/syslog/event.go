@@ -0,0 +1,89 @@
+package syslog
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"sort"
+	"strconv"
+)
+
+// facilityNames are the syslog facility keywords, by facility code.
+var facilityNames = []string{
+	"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news",
+	"uucp", "cron", "authpriv", "ftp", "ntp", "security", "console", "solaris-cron",
+	"local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+}
+
+// severityNames are the syslog severity keywords, by severity code.
+var severityNames = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}
+
+// severities maps syslog severities to event severities: emerg and alert
+// are CRITICAL, crit MAJOR, err MINOR, warning WARNING, and the rest INFO.
+var severities = []l8events.Severity{
+	l8events.Severity_SEVERITY_CRITICAL,
+	l8events.Severity_SEVERITY_CRITICAL,
+	l8events.Severity_SEVERITY_MAJOR,
+	l8events.Severity_SEVERITY_MINOR,
+	l8events.Severity_SEVERITY_WARNING,
+	l8events.Severity_SEVERITY_INFO,
+	l8events.Severity_SEVERITY_INFO,
+	l8events.Severity_SEVERITY_INFO,
+}
+
+func facilityName(facility int) string {
+	if facility >= 0 && facility < len(facilityNames) {
+		return facilityNames[facility]
+	}
+	return strconv.Itoa(facility)
+}
+
+// toEvent builds the SYSLOG event of a message: category is the facility,
+// subcategory and source_identifier the app-name (TAG), and the header
+// fields and structured data become attributes.
+func toEvent(msg *Message, raw []byte, sender string) *alm.Event {
+	event := &alm.Event{
+		EventType:        alm.AlmEventType_ALM_EVENT_TYPE_SYSLOG,
+		NodeName:         msg.Hostname,
+		SourceIdentifier: msg.AppName,
+		Severity:         severities[msg.Severity],
+		Message:          msg.Text,
+		RawData:          string(raw),
+		Category:         facilityName(msg.Facility),
+		Subcategory:      msg.AppName,
+	}
+	if event.Message == "" {
+		event.Message = "syslog " + facilityName(msg.Facility) + "." + severityNames[msg.Severity]
+	}
+	if event.NodeName == "" {
+		event.NodeName = sender
+	}
+	if !msg.Timestamp.IsZero() {
+		event.OccurredAt = msg.Timestamp.Unix()
+	}
+
+	attrs := []*alm.EventAttribute{
+		{Key: "facility", Value: facilityName(msg.Facility)},
+		{Key: "syslogSeverity", Value: severityNames[msg.Severity]},
+		{Key: "senderAddress", Value: sender},
+	}
+	for _, a := range []struct{ key, value string }{
+		{"hostname", msg.Hostname},
+		{"appName", msg.AppName},
+		{"procId", msg.ProcId},
+		{"msgId", msg.MsgId},
+	} {
+		if a.value != "" {
+			attrs = append(attrs, &alm.EventAttribute{Key: a.key, Value: a.value})
+		}
+	}
+	keys := make([]string, 0, len(msg.StructuredData))
+	for k := range msg.StructuredData {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		attrs = append(attrs, &alm.EventAttribute{Key: k, Value: msg.StructuredData[k]})
+	}
+	event.Attributes = attrs
+	return event
+}
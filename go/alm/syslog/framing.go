@@ -0,0 +1,77 @@
+package syslog
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// maxFrameLengthDigits bounds the MSG-LEN prefix of an octet-counted frame.
+const maxFrameLengthDigits = 10
+
+// readFrame reads the next syslog message of a TCP stream (RFC 6587). A
+// frame that starts with a digit is octet-counted ("MSG-LEN SP MSG"), so
+// messages of any size up to maxSize arrive whole; otherwise the frame is a
+// newline-terminated message, truncated at maxSize.
+func readFrame(r *bufio.Reader, maxSize int) ([]byte, error) {
+	first, err := r.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	if first[0] >= '0' && first[0] <= '9' {
+		return readOctetCounted(r, maxSize)
+	}
+	return readLine(r, maxSize)
+}
+
+func readOctetCounted(r *bufio.Reader, maxSize int) ([]byte, error) {
+	var digits []byte
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if c == ' ' {
+			break
+		}
+		if c < '0' || c > '9' || len(digits) == maxFrameLengthDigits {
+			return nil, errors.New("invalid octet-counted frame length")
+		}
+		digits = append(digits, c)
+	}
+	n, err := strconv.Atoi(string(digits))
+	if err != nil {
+		return nil, err
+	}
+	if n > maxSize {
+		return nil, fmt.Errorf("frame of %d bytes exceeds the maximum of %d", n, maxSize)
+	}
+	frame := make([]byte, n)
+	if _, err := io.ReadFull(r, frame); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
+
+func readLine(r *bufio.Reader, maxSize int) ([]byte, error) {
+	var line []byte
+	for {
+		chunk, err := r.ReadSlice('\n')
+		if len(line) < maxSize {
+			room := maxSize - len(line)
+			if len(chunk) > room {
+				chunk = chunk[:room]
+			}
+			line = append(line, chunk...)
+		}
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if err == io.EOF && len(line) > 0 {
+			return line, nil
+		}
+		return line, err
+	}
+}
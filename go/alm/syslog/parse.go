@@ -0,0 +1,214 @@
+package syslog
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultPriority is user.notice, the priority RFC 3164 assigns to
+	// messages that arrive without one.
+	defaultPriority = 13
+	nilValue        = "-"
+)
+
+// Message is a parsed syslog message of either RFC.
+type Message struct {
+	Facility  int
+	Severity  int
+	Timestamp time.Time // zero when the message carries none
+	Hostname  string
+	AppName   string
+	ProcId    string
+	MsgId     string
+	// StructuredData holds the RFC 5424 SD-PARAMs as "<sd-id>.<param>" -> value.
+	StructuredData map[string]string
+	Text           string
+	RFC5424        bool
+}
+
+// Parse parses an RFC 5424 message, or else an RFC 3164 (BSD) message.
+// BSD messages are parsed leniently, as senders rarely follow RFC 3164
+// exactly: anything that cannot be recognized is kept in the message text.
+func Parse(raw []byte, now time.Time) (*Message, error) {
+	line := strings.TrimRight(string(raw), "\r\n\x00")
+	if line == "" {
+		return nil, errors.New("empty syslog message")
+	}
+
+	pri, rest, ok := parsePriority(line)
+	if !ok {
+		pri, rest = defaultPriority, line
+	}
+	msg := &Message{Facility: pri / 8, Severity: pri % 8}
+
+	if version, body, ok := strings.Cut(rest, " "); ok && version == "1" {
+		if err := parse5424(msg, body); err != nil {
+			return nil, err
+		}
+		return msg, nil
+	}
+	parse3164(msg, rest, now)
+	return msg, nil
+}
+
+// parsePriority parses the leading "<PRI>" of a message.
+func parsePriority(line string) (int, string, bool) {
+	if !strings.HasPrefix(line, "<") {
+		return 0, line, false
+	}
+	end := strings.IndexByte(line, '>')
+	if end < 2 || end > 4 {
+		return 0, line, false
+	}
+	pri, err := strconv.Atoi(line[1:end])
+	if err != nil || pri < 0 || pri > 191 {
+		return 0, line, false
+	}
+	return pri, line[end+1:], true
+}
+
+// parse5424 parses "TIMESTAMP HOSTNAME APP-NAME PROCID MSGID SD [MSG]".
+func parse5424(msg *Message, body string) error {
+	msg.RFC5424 = true
+	fields := make([]string, 5)
+	for i := range fields {
+		field, rest, ok := strings.Cut(body, " ")
+		if !ok {
+			return errors.New("truncated RFC 5424 header")
+		}
+		fields[i], body = field, rest
+	}
+
+	if fields[0] != nilValue {
+		ts, err := time.Parse(time.RFC3339Nano, fields[0])
+		if err != nil {
+			return errors.New("invalid RFC 5424 timestamp: " + fields[0])
+		}
+		msg.Timestamp = ts
+	}
+	msg.Hostname = valueOf(fields[1])
+	msg.AppName = valueOf(fields[2])
+	msg.ProcId = valueOf(fields[3])
+	msg.MsgId = valueOf(fields[4])
+
+	sd, text, err := parseStructuredData(body)
+	if err != nil {
+		return err
+	}
+	msg.StructuredData = sd
+	// A UTF-8 MSG starts with a BOM
+	msg.Text = strings.TrimPrefix(strings.TrimPrefix(text, " "), "\ufeff")
+	return nil
+}
+
+// parseStructuredData parses the STRUCTURED-DATA of an RFC 5424 message and
+// returns it with the rest of the message.
+func parseStructuredData(s string) (map[string]string, string, error) {
+	if strings.HasPrefix(s, nilValue) {
+		return nil, s[1:], nil
+	}
+	sd := make(map[string]string)
+	for strings.HasPrefix(s, "[") {
+		end := strings.IndexAny(s, " ]")
+		if end < 0 {
+			return nil, "", errors.New("unterminated structured data element")
+		}
+		id := s[1:end]
+		s = s[end:]
+		for strings.HasPrefix(s, " ") {
+			s = s[1:]
+			eq := strings.Index(s, "=\"")
+			if eq < 0 {
+				return nil, "", errors.New("invalid structured data parameter in " + id)
+			}
+			name := s[:eq]
+			value, rest, err := parseParamValue(s[eq+2:])
+			if err != nil {
+				return nil, "", err
+			}
+			sd[id+"."+name] = value
+			s = rest
+		}
+		if !strings.HasPrefix(s, "]") {
+			return nil, "", errors.New("unterminated structured data element " + id)
+		}
+		s = s[1:]
+	}
+	if len(sd) == 0 {
+		return nil, "", errors.New("invalid structured data")
+	}
+	return sd, s, nil
+}
+
+// parseParamValue reads a PARAM-VALUE up to its closing quote, unescaping
+// \", \\ and \].
+func parseParamValue(s string) (string, string, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s) && (s[i+1] == '"' || s[i+1] == '\\' || s[i+1] == ']'):
+			b.WriteByte(s[i+1])
+			i++
+		case c == '"':
+			return b.String(), s[i+1:], nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", errors.New("unterminated structured data parameter value")
+}
+
+// parse3164 parses "TIMESTAMP HOSTNAME TAG[PID]: MSG". The year and zone of
+// the timestamp are taken from now, moving it a year back if it would
+// otherwise lie in the future (messages logged just before New Year).
+func parse3164(msg *Message, body string, now time.Time) {
+	if len(body) >= len(time.Stamp) {
+		if ts, err := time.ParseInLocation(time.Stamp, body[:len(time.Stamp)], now.Location()); err == nil {
+			ts = ts.AddDate(now.Year(), 0, 0)
+			if ts.After(now.Add(24 * time.Hour)) {
+				ts = ts.AddDate(-1, 0, 0)
+			}
+			msg.Timestamp = ts
+			body = strings.TrimPrefix(body[len(time.Stamp):], " ")
+			if host, rest, ok := strings.Cut(body, " "); ok && !isTag(host) {
+				msg.Hostname, body = host, rest
+			}
+		}
+	}
+
+	msg.Text = body
+	tagEnd := strings.IndexAny(body, ":[ ")
+	if tagEnd <= 0 || tagEnd > 48 || body[tagEnd] == ' ' {
+		return
+	}
+	tag, rest := body[:tagEnd], body[tagEnd:]
+	if strings.HasPrefix(rest, "[") {
+		end := strings.IndexByte(rest, ']')
+		if end < 0 {
+			return
+		}
+		msg.ProcId, rest = rest[1:end], rest[end+1:]
+	}
+	if !strings.HasPrefix(rest, ":") {
+		msg.ProcId = ""
+		return
+	}
+	msg.AppName = tag
+	msg.Text = strings.TrimPrefix(rest[1:], " ")
+}
+
+// isTag reports whether the word after a BSD timestamp is the TAG of a
+// message without a HOSTNAME, rather than the HOSTNAME.
+func isTag(word string) bool {
+	return strings.HasSuffix(word, ":") || strings.Contains(word, "[")
+}
+
+func valueOf(field string) string {
+	if field == nilValue {
+		return ""
+	}
+	return field
+}
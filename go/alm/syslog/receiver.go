@@ -0,0 +1,217 @@
+package syslog
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/alm/ingest"
+	"github.com/saichler/l8types/go/ifs"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// Config configures a syslog Receiver.
+type Config struct {
+	// UDPAddress and TCPAddress are the addresses to listen on, e.g.
+	// "0.0.0.0:514"; empty disables the transport.
+	UDPAddress string
+	TCPAddress string
+	// MaxMessageSize is the largest message accepted, in bytes.
+	MaxMessageSize int
+	// IdleTimeout closes a TCP connection that sends nothing for this long.
+	IdleTimeout time.Duration
+	// MaxConnections is the most TCP connections open at once.
+	MaxConnections int
+}
+
+// Receiver receives RFC 3164 and RFC 5424 syslog messages over UDP and TCP
+// and posts each one as an ALM_EVENT_TYPE_SYSLOG event.
+type Receiver struct {
+	maxSize  int
+	idle     time.Duration
+	maxConns int
+	udp      net.PacketConn
+	tcp      net.Listener
+	mu       sync.Mutex
+	conns    map[net.Conn]bool
+	closed   bool
+	resolver *ingest.NodeResolver
+	vnic     ifs.IVNic
+}
+
+// Activate starts the syslog receiver on common.SYSLOG_UDP_ADDRESS and
+// common.SYSLOG_TCP_ADDRESS, unless both are empty.
+func Activate(vnic ifs.IVNic) {
+	if common.SYSLOG_UDP_ADDRESS == "" && common.SYSLOG_TCP_ADDRESS == "" {
+		return
+	}
+	_, err := Start(Config{
+		UDPAddress:     common.SYSLOG_UDP_ADDRESS,
+		TCPAddress:     common.SYSLOG_TCP_ADDRESS,
+		MaxMessageSize: common.SYSLOG_MAX_MESSAGE_SIZE,
+		IdleTimeout:    time.Duration(common.SYSLOG_TCP_IDLE_SECONDS) * time.Second,
+		MaxConnections: common.SYSLOG_TCP_MAX_CONNECTIONS,
+	}, vnic)
+	if err != nil {
+		fmt.Printf("[syslog] failed to start syslog receiver: %v\n", err)
+	}
+}
+
+// Start binds the configured transports and starts receiving.
+func Start(cfg Config, vnic ifs.IVNic) (*Receiver, error) {
+	if cfg.MaxMessageSize <= 0 {
+		return nil, errors.New("MaxMessageSize must be greater than 0")
+	}
+	if cfg.TCPAddress != "" && (cfg.IdleTimeout <= 0 || cfg.MaxConnections <= 0) {
+		return nil, errors.New("IdleTimeout and MaxConnections must be greater than 0")
+	}
+	r := &Receiver{
+		maxSize:  cfg.MaxMessageSize,
+		idle:     cfg.IdleTimeout,
+		maxConns: cfg.MaxConnections,
+		conns:    make(map[net.Conn]bool),
+		resolver: ingest.NewNodeResolver(),
+		vnic:     vnic,
+	}
+	if cfg.UDPAddress != "" {
+		udp, err := net.ListenPacket("udp", cfg.UDPAddress)
+		if err != nil {
+			return nil, err
+		}
+		r.udp = udp
+		go r.serveUDP()
+		fmt.Printf("[syslog] listening on udp %s\n", cfg.UDPAddress)
+	}
+	if cfg.TCPAddress != "" {
+		tcp, err := net.Listen("tcp", cfg.TCPAddress)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+		r.tcp = tcp
+		go r.serveTCP()
+		fmt.Printf("[syslog] listening on tcp %s\n", cfg.TCPAddress)
+	}
+	return r, nil
+}
+
+// Close stops the receiver and drops open TCP connections.
+func (r *Receiver) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	if r.udp != nil {
+		r.udp.Close()
+	}
+	if r.tcp != nil {
+		r.tcp.Close()
+	}
+	for conn := range r.conns {
+		conn.Close()
+	}
+}
+
+func (r *Receiver) isClosed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.closed
+}
+
+// serveUDP handles one message per datagram.
+func (r *Receiver) serveUDP() {
+	buf := make([]byte, r.maxSize)
+	for {
+		n, addr, err := r.udp.ReadFrom(buf)
+		if err != nil {
+			if r.isClosed() {
+				return
+			}
+			fmt.Printf("[syslog] udp read failed: %v\n", err)
+			continue
+		}
+		raw := make([]byte, n)
+		copy(raw, buf[:n])
+		r.handle(raw, hostOf(addr))
+	}
+}
+
+func (r *Receiver) serveTCP() {
+	for {
+		conn, err := r.tcp.Accept()
+		if err != nil {
+			if r.isClosed() {
+				return
+			}
+			fmt.Printf("[syslog] tcp accept failed: %v\n", err)
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+		r.mu.Lock()
+		if r.closed {
+			r.mu.Unlock()
+			conn.Close()
+			return
+		}
+		if len(r.conns) >= r.maxConns {
+			r.mu.Unlock()
+			fmt.Printf("[syslog] refusing connection from %s: %d connections open\n", hostOf(conn.RemoteAddr()), r.maxConns)
+			conn.Close()
+			continue
+		}
+		r.conns[conn] = true
+		r.mu.Unlock()
+		go r.serveConn(conn)
+	}
+}
+
+// serveConn handles the frames of one TCP connection until it is closed,
+// idle for longer than the idle timeout, or a frame is malformed.
+func (r *Receiver) serveConn(conn net.Conn) {
+	defer func() {
+		r.mu.Lock()
+		delete(r.conns, conn)
+		r.mu.Unlock()
+		conn.Close()
+	}()
+	sender := hostOf(conn.RemoteAddr())
+	reader := bufio.NewReader(conn)
+	for {
+		conn.SetReadDeadline(time.Now().Add(r.idle))
+		frame, err := readFrame(reader, r.maxSize)
+		if err != nil {
+			if err != io.EOF && !r.isClosed() {
+				fmt.Printf("[syslog] dropping connection from %s: %v\n", sender, err)
+			}
+			return
+		}
+		r.handle(frame, sender)
+	}
+}
+
+func (r *Receiver) handle(raw []byte, sender string) {
+	msg, err := Parse(raw, time.Now())
+	if err != nil {
+		fmt.Printf("[syslog] dropping message from %s: %v\n", sender, err)
+		return
+	}
+	event := toEvent(msg, raw, sender)
+	source := msg.Hostname
+	if source == "" {
+		source = sender
+	}
+	event.NodeId = r.resolver.Resolve(source, r.vnic)
+	if err := ingest.PostEvent(event, r.vnic); err != nil {
+		fmt.Printf("[syslog] failed to post message from %s: %v\n", sender, err)
+	}
+}
+
+func hostOf(addr net.Addr) string {
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+	return host
+}
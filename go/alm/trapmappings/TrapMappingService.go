@@ -0,0 +1,31 @@
+package trapmappings
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+const (
+	ServiceName = "TrapMap"
+	ServiceArea = byte(10)
+)
+
+func Activate(creds, dbname string, vnic ifs.IVNic) {
+	common.ActivateService(common.ServiceConfig{
+		ServiceName: ServiceName, ServiceArea: ServiceArea,
+		PrimaryKey: "MappingId", Callback: newTrapMappingServiceCallback(vnic),
+	}, &alm.TrapMapping{}, &alm.TrapMappingList{}, creds, dbname, vnic)
+}
+
+func TrapMappings(vnic ifs.IVNic) (ifs.IServiceHandler, bool) {
+	return common.ServiceHandler(ServiceName, ServiceArea, vnic)
+}
+
+func TrapMapping(id string, vnic ifs.IVNic) (*alm.TrapMapping, error) {
+	result, err := common.GetEntity(ServiceName, ServiceArea, &alm.TrapMapping{MappingId: id}, vnic)
+	if err != nil || result == nil {
+		return nil, err
+	}
+	return result.(*alm.TrapMapping), nil
+}
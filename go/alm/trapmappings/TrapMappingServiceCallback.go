@@ -0,0 +1,37 @@
+package trapmappings
+
+import (
+	"errors"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"strings"
+)
+
+func newTrapMappingServiceCallback(vnic ifs.IVNic) ifs.IServiceCallback {
+	return common.NewValidation(&alm.TrapMapping{}, vnic).
+		Require(func(e interface{}) string { return e.(*alm.TrapMapping).MappingId }, "MappingId").
+		Require(func(e interface{}) string { return e.(*alm.TrapMapping).Name }, "Name").
+		Require(func(e interface{}) string { return e.(*alm.TrapMapping).TrapOid }, "TrapOid").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.TrapMapping).Status) }, alm.AlmPolicyStatus_name, "Status").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.TrapMapping).Severity) }, l8events.Severity_name, "Severity").
+		BeforeAction(normalizeTrapOid).
+		Build()
+}
+
+// normalizeTrapOid stores trap OIDs in the dotted form the receiver reports,
+// with a leading dot, and rejects anything that is not a numeric OID.
+func normalizeTrapOid(mapping *alm.TrapMapping, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST && action != ifs.PUT {
+		return nil
+	}
+	oid := strings.TrimPrefix(strings.TrimSpace(mapping.TrapOid), ".")
+	for _, arc := range strings.Split(oid, ".") {
+		if arc == "" || strings.Trim(arc, "0123456789") != "" {
+			return errors.New("trapOid must be a numeric OID, e.g. .1.3.6.1.6.3.1.1.5.3")
+		}
+	}
+	mapping.TrapOid = "." + oid
+	return nil
+}
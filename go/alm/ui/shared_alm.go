@@ -12,6 +12,8 @@ func RegisterAlmTypes(resources ifs.IResources) {
 	common.RegisterType(resources, &alm.AlarmDefinition{}, &alm.AlarmDefinitionList{}, "DefinitionId")
 	common.RegisterType(resources, &alm.Alarm{}, &alm.AlarmList{}, "AlarmId")
 	common.RegisterType(resources, &alm.Event{}, &alm.EventList{}, "EventId")
+	resources.Registry().Register(&alm.AlarmActionRequest{})
+	resources.Registry().Register(&alm.AlarmActionResponse{})
 
 	// Correlation
 	common.RegisterType(resources, &alm.CorrelationRule{}, &alm.CorrelationRuleList{}, "RuleId")
@@ -19,14 +21,29 @@ func RegisterAlmTypes(resources ifs.IResources) {
 	// Policies
 	common.RegisterType(resources, &alm.NotificationPolicy{}, &alm.NotificationPolicyList{}, "PolicyId")
 	common.RegisterType(resources, &alm.EscalationPolicy{}, &alm.EscalationPolicyList{}, "PolicyId")
+	common.RegisterType(resources, &alm.EscalationState{}, &alm.EscalationStateList{}, "StateId")
 
 	// Operations
 	common.RegisterType(resources, &alm.MaintenanceWindow{}, &alm.MaintenanceWindowList{}, "WindowId")
 	common.RegisterType(resources, &alm.AlarmFilter{}, &alm.AlarmFilterList{}, "FilterId")
 
+	// Ingestion
+	common.RegisterType(resources, &alm.TrapMapping{}, &alm.TrapMappingList{}, "MappingId")
+	common.RegisterType(resources, &alm.WebhookMapping{}, &alm.WebhookMappingList{}, "MappingId")
+	common.RegisterType(resources, &alm.HeartbeatMonitor{}, &alm.HeartbeatMonitorList{}, "MonitorId")
+	common.RegisterType(resources, &alm.NormalizationRule{}, &alm.NormalizationRuleList{}, "RuleId")
+	resources.Registry().Register(&alm.AlertmanagerWebhook{})
+	resources.Registry().Register(&alm.GenericWebhook{})
+	resources.Registry().Register(&alm.WebhookResponse{})
+
 	// Archive
 	common.RegisterType(resources, &alm.ArchivedAlarm{}, &alm.ArchivedAlarmList{}, "AlarmId")
 	common.RegisterType(resources, &alm.ArchivedEvent{}, &alm.ArchivedEventList{}, "EventId")
+	common.RegisterType(resources, &alm.ArchiveRetentionPolicy{}, &alm.ArchiveRetentionPolicyList{}, "PolicyId")
+	resources.Registry().Register(&alm.ArchiveRequest{})
+	resources.Registry().Register(&alm.ArchiveResponse{})
+	resources.Registry().Register(&alm.ArchiveExportRequest{})
+	resources.Registry().Register(&alm.ArchiveExportResponse{})
 
 	// External types used by EnrichmentService
 	resources.Registry().Register(&l8topo.L8Topology{})
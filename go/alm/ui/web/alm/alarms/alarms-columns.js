@@ -18,6 +18,7 @@ Table column definitions for Alarm, AlarmDefinition, AlarmFilter
             ...col.status('severity', 'Severity', null, render.severity),
             ...col.status('state', 'State', null, render.state),
             ...col.col('nodeName', 'Node'),
+            ...col.col('assignedTo', 'Assigned To'),
             ...col.datetime('firstOccurrence', 'First Occurrence'),
             ...col.col('occurrenceCount', 'Count'),
             ...col.boolean('isRootCause', 'Root Cause'),
@@ -31,7 +31,9 @@ Uses Layer8FormFactory for reduced boilerplate
                 ...ro(f.text('nodeName', 'Node Name')),
                 ...ro(f.text('linkId', 'Link ID')),
                 ...ro(f.text('location', 'Location')),
-                ...ro(f.text('sourceIdentifier', 'Source Identifier'))
+                ...ro(f.text('sourceIdentifier', 'Source Identifier')),
+                // Set through the AlmAction service
+                ...ro(f.text('assignedTo', 'Assigned To'))
             ]),
             f.section('Timing', [
                 ...f.datetime('firstOccurrence', 'First Occurrence'),
@@ -67,7 +69,8 @@ Uses Layer8FormFactory for reduced boilerplate
             ]),
             f.section('Deduplication', [
                 ...f.checkbox('dedupEnabled', 'Dedup Enabled'),
-                ...f.text('dedupKeyExpression', 'Dedup Key Expression')
+                ...f.text('dedupKeyExpression', 'Dedup Key Expression'),
+                ...f.checkbox('dedupEscalateSeverity', 'Escalate Severity on Repeat')
             ])
         ]),
 
@@ -18,7 +18,11 @@ Layer8ModuleConfigFactory.create({
         'events': {
             label: 'Events',
             services: [
-                { key: 'events', label: 'Events', endpoint: '/10/Event', model: 'Event', readOnly: true }
+                { key: 'events', label: 'Events', endpoint: '/10/Event', model: 'Event', readOnly: true },
+                { key: 'trap-mappings', label: 'Trap Mappings', endpoint: '/10/TrapMap', model: 'TrapMapping' },
+                { key: 'webhook-mappings', label: 'Webhook Mappings', endpoint: '/10/WebhkMap', model: 'WebhookMapping' },
+                { key: 'heartbeat-monitors', label: 'Heartbeat Monitors', endpoint: '/10/HbMonitor', model: 'HeartbeatMonitor' },
+                { key: 'normalization-rules', label: 'Normalization Rules', endpoint: '/10/NormRule', model: 'NormalizationRule' }
             ]
         },
         'correlation': {
@@ -44,7 +48,8 @@ Layer8ModuleConfigFactory.create({
             label: 'Archive',
             services: [
                 { key: 'archived-alarms', label: 'Archived Alarms', endpoint: '/10/ArcAlarm', model: 'ArchivedAlarm', readOnly: true },
-                { key: 'archived-events', label: 'Archived Events', endpoint: '/10/ArcEvent', model: 'ArchivedEvent', readOnly: true }
+                { key: 'archived-events', label: 'Archived Events', endpoint: '/10/ArcEvent', model: 'ArchivedEvent', readOnly: true },
+                { key: 'retention-policies', label: 'Retention', endpoint: '/10/ArcPolicy', model: 'ArchiveRetentionPolicy' }
             ]
         }
     },
@@ -26,7 +26,11 @@ Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
             {
                 key: 'events', label: 'Events', icon: '\u26A1',
                 services: [
-                    { key: 'events', label: 'Events', icon: '\u26A1', isDefault: true }
+                    { key: 'events', label: 'Events', icon: '\u26A1', isDefault: true },
+                    { key: 'trap-mappings', label: 'Trap Mappings', icon: '\uD83D\uDCE1' },
+                    { key: 'webhook-mappings', label: 'Webhook Mappings', icon: '\uD83D\uDD17' },
+                    { key: 'heartbeat-monitors', label: 'Heartbeat Monitors', icon: '\uD83D\uDC93' },
+                    { key: 'normalization-rules', label: 'Normalization Rules', icon: '\uD83E\uDDF9' }
                 ]
             },
             {
@@ -52,7 +56,8 @@ Layer 8 Ecosystem is licensed under the Apache License, Version 2.0.
                 key: 'archive', label: 'Archive', icon: '\uD83D\uDDC4\uFE0F',
                 services: [
                     { key: 'archived-alarms', label: 'Archived Alarms', icon: '\uD83D\uDD14', isDefault: true },
-                    { key: 'archived-events', label: 'Archived Events', icon: '\u26A1' }
+                    { key: 'archived-events', label: 'Archived Events', icon: '\u26A1' },
+                    { key: 'retention-policies', label: 'Retention', icon: '\uD83D\uDDD1\uFE0F' }
                 ]
             }
         ]
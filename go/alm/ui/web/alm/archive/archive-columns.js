@@ -1,6 +1,6 @@
 /*
 Layer 8 Alarms - Archive Column Definitions
-Table column configurations for ArchivedAlarm, ArchivedEvent
+Table column configurations for ArchivedAlarm, ArchivedEvent, ArchiveRetentionPolicy
 */
 
 (function() {
@@ -32,6 +32,17 @@ Table column configurations for ArchivedAlarm, ArchivedEvent
             ...col.datetime('occurredAt', 'Occurred At'),
             ...col.datetime('archivedAt', 'Archived At'),
             ...col.col('archivedBy', 'Archived By')
+        ],
+
+        ArchiveRetentionPolicy: [
+            ...col.id('policyId', 'Policy ID'),
+            ...col.col('name', 'Name'),
+            ...col.status('status', 'Status', null, AlmPolicies.render.policyStatus),
+            ...col.col('maxAgeDays', 'Max Age (days)'),
+            ...col.col('dryRun', 'Dry Run'),
+            ...col.datetime('lastRunAt', 'Last Run'),
+            ...col.col('lastAlarmCount', 'Alarms'),
+            ...col.col('lastEventCount', 'Events')
         ]
     };
 
@@ -1,6 +1,6 @@
 /*
 Layer 8 Alarms - Archive Form Definitions
-All fields are display-only (immutable archived records)
+Archived records are display-only; retention policies are editable
 */
 
 (function() {
@@ -21,7 +21,8 @@ All fields are display-only (immutable archived records)
                 ...f.text('nodeId', 'Node ID'),
                 ...f.text('nodeName', 'Node Name'),
                 ...f.text('location', 'Location'),
-                ...f.text('sourceIdentifier', 'Source Identifier')
+                ...f.text('sourceIdentifier', 'Source Identifier'),
+                ...f.text('assignedTo', 'Assigned To')
             ]),
             f.section('Timing', [
                 ...f.datetime('firstOccurrence', 'First Occurrence'),
@@ -54,12 +55,39 @@ All fields are display-only (immutable archived records)
                 ...f.datetime('archivedAt', 'Archived At'),
                 ...f.text('archivedBy', 'Archived By')
             ])
+        ]),
+
+        ArchiveRetentionPolicy: f.form('Retention Policy', [
+            f.section('Policy Details', [
+                ...f.text('name', 'Name', true),
+                ...f.textarea('description', 'Description'),
+                ...f.select('status', 'Status', AlmPolicies.enums.POLICY_STATUS),
+                ...f.checkbox('purgeAlarms', 'Purge Archived Alarms'),
+                ...f.checkbox('purgeEvents', 'Purge Archived Events'),
+                ...f.checkbox('dryRun', 'Dry Run (report only)')
+            ]),
+            f.section('Match Criteria', [
+                ...f.number('maxAgeDays', 'Max Age (days)', true),
+                ...f.multiselect('severities', 'Severities', enums.ALARM_SEVERITY),
+                ...f.text('alarmDefinitionIds', 'Alarm Definition IDs')
+            ]),
+            f.section('Export', [
+                ...f.checkbox('exportBeforePurge', 'Export Before Purge'),
+                ...f.text('exportDirectory', 'Export Subdirectory')
+            ]),
+            f.section('Last Run', [
+                ...f.datetime('lastRunAt', 'Last Run At'),
+                ...f.number('lastAlarmCount', 'Alarms Matched'),
+                ...f.number('lastEventCount', 'Events Matched'),
+                ...f.text('lastExportFile', 'Export File')
+            ])
         ])
     };
 
     AlmArchive.primaryKeys = {
         ArchivedAlarm: 'alarmId',
-        ArchivedEvent: 'eventId'
+        ArchivedEvent: 'eventId',
+        ArchiveRetentionPolicy: 'policyId'
     };
 
 })();
@@ -13,7 +13,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 // ALM Events Module - Column Definitions
-// Table column configurations for Event
+// Table column configurations for Event, TrapMapping, WebhookMapping, HeartbeatMonitor, NormalizationRule
 
 (function() {
     'use strict';
@@ -33,6 +33,48 @@ limitations under the License.
             ...col.col('message', 'Message'),
             ...col.col('category', 'Category'),
             ...col.datetime('occurredAt', 'Occurred At')
+        ],
+
+        TrapMapping: [
+            ...col.id('mappingId', 'Mapping ID'),
+            ...col.col('name', 'Name'),
+            ...col.status('status', 'Status', null, AlmPolicies.render.policyStatus),
+            ...col.col('trapOid', 'Trap OID'),
+            ...col.enum('severity', 'Severity', null, AlmAlarms.render.severity),
+            ...col.col('category', 'Category'),
+            ...col.col('subcategory', 'Subcategory')
+        ],
+
+        WebhookMapping: [
+            ...col.id('mappingId', 'Mapping ID'),
+            ...col.col('name', 'Name'),
+            ...col.status('status', 'Status', null, AlmPolicies.render.policyStatus),
+            ...col.enum('eventType', 'Event Type', null, render.eventType),
+            ...col.col('eventsPath', 'Events Path'),
+            ...col.col('messagePath', 'Message Path'),
+            ...col.col('category', 'Category')
+        ],
+
+        HeartbeatMonitor: [
+            ...col.id('monitorId', 'Monitor ID'),
+            ...col.col('name', 'Name'),
+            ...col.status('status', 'Status', null, AlmPolicies.render.policyStatus),
+            ...col.col('nodeId', 'Node ID'),
+            ...col.col('sourceIdentifier', 'Source Identifier'),
+            ...col.col('intervalSeconds', 'Interval (s)'),
+            ...col.col('missedIntervals', 'Missed Intervals'),
+            ...col.enum('severity', 'Severity', null, AlmAlarms.render.severity)
+        ],
+
+        NormalizationRule: [
+            ...col.id('ruleId', 'Rule ID'),
+            ...col.col('name', 'Name'),
+            ...col.status('status', 'Status', null, AlmPolicies.render.policyStatus),
+            ...col.col('priority', 'Priority'),
+            ...col.enum('eventTypeFilter', 'Event Type', null, render.eventType),
+            ...col.enum('field', 'Field', null, render.normalizationField),
+            ...col.col('pattern', 'Pattern'),
+            ...col.col('category', 'Category')
         ]
     };
 
@@ -23,12 +23,20 @@ Uses shared L8EventsEnums for event state, keeps alarm-specific event types loca
         'Syslog'
     ]);
 
+    // NormalizationField: the event field a normalization rule parses
+    const NORMALIZATION_FIELD = factory.simple([
+        'Unspecified',
+        'Message',
+        'Raw Data'
+    ]);
+
     // Use shared event state from l8events
     const EVENT_PROCESSING_STATE = L8EventsEnums.EVENT_STATE;
 
     // Enum exports
     AlmEvents.enums = {
         EVENT_TYPE: EVENT_TYPE.enum,
+        NORMALIZATION_FIELD: NORMALIZATION_FIELD.enum,
         EVENT_PROCESSING_STATE: EVENT_PROCESSING_STATE.enum,
         EVENT_PROCESSING_STATE_CLASSES: EVENT_PROCESSING_STATE.classes
     };
@@ -36,6 +44,7 @@ Uses shared L8EventsEnums for event state, keeps alarm-specific event types loca
     // Renderers
     AlmEvents.render = {
         eventType: (value) => renderEnum(value, EVENT_TYPE.enum),
+        normalizationField: (value) => renderEnum(value, NORMALIZATION_FIELD.enum),
         processingState: L8EventsEnums.render.eventState
     };
 
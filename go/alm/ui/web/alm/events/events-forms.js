@@ -13,7 +13,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 // ALM Events Module - Form Definitions & Primary Keys
-// Form configurations for Event
+// Form configurations for Event, TrapMapping, WebhookMapping, HeartbeatMonitor, NormalizationRule
 
 (function() {
     'use strict';
@@ -25,7 +25,11 @@ limitations under the License.
 
     // Primary keys per model
     AlmEvents.primaryKeys = {
-        Event: 'eventId'
+        Event: 'eventId',
+        TrapMapping: 'mappingId',
+        WebhookMapping: 'mappingId',
+        HeartbeatMonitor: 'monitorId',
+        NormalizationRule: 'ruleId'
     };
 
     // Form definitions
@@ -52,6 +56,81 @@ limitations under the License.
                 ...f.datetime('receivedAt', 'Received At'),
                 ...f.datetime('processedAt', 'Processed At')
             ])
+        ]),
+
+        TrapMapping: f.form('Trap Mapping', [
+            f.section('Mapping Details', [
+                ...f.text('name', 'Name', true),
+                ...f.textarea('description', 'Description'),
+                ...f.select('status', 'Status', AlmPolicies.enums.POLICY_STATUS),
+                ...f.text('trapOid', 'Trap OID', true)
+            ]),
+            f.section('Event Classification', [
+                ...f.select('severity', 'Severity', AlmAlarms.enums.ALARM_SEVERITY),
+                ...f.text('category', 'Category'),
+                ...f.text('subcategory', 'Subcategory'),
+                ...f.text('message', 'Message')
+            ])
+        ]),
+
+        WebhookMapping: f.form('Webhook Mapping', [
+            f.section('Mapping Details', [
+                ...f.text('name', 'Name', true),
+                ...f.textarea('description', 'Description'),
+                ...f.select('status', 'Status', AlmPolicies.enums.POLICY_STATUS),
+                ...f.select('eventType', 'Event Type', enums.EVENT_TYPE),
+                ...f.text('eventsPath', 'Events Path')
+            ]),
+            f.section('Field Paths', [
+                ...f.text('messagePath', 'Message Path', true),
+                ...f.text('nodePath', 'Node Path'),
+                ...f.text('sourcePath', 'Source Path'),
+                ...f.text('severityPath', 'Severity Path'),
+                ...f.text('categoryPath', 'Category Path'),
+                ...f.text('subcategoryPath', 'Subcategory Path'),
+                ...f.text('occurredAtPath', 'Occurred At Path'),
+                ...f.text('statusPath', 'Status Path')
+            ]),
+            f.section('Defaults', [
+                ...f.select('defaultSeverity', 'Default Severity', AlmAlarms.enums.ALARM_SEVERITY),
+                ...f.text('category', 'Category')
+            ])
+        ]),
+
+        HeartbeatMonitor: f.form('Heartbeat Monitor', [
+            f.section('Monitor Details', [
+                ...f.text('name', 'Name', true),
+                ...f.textarea('description', 'Description'),
+                ...f.select('status', 'Status', AlmPolicies.enums.POLICY_STATUS),
+                ...f.text('nodeId', 'Node ID'),
+                ...f.text('sourceIdentifier', 'Source Identifier')
+            ]),
+            f.section('Expectation', [
+                ...f.number('intervalSeconds', 'Interval (seconds, 0 = learn)'),
+                ...f.number('missedIntervals', 'Missed Intervals'),
+                ...f.select('severity', 'Severity', AlmAlarms.enums.ALARM_SEVERITY)
+            ])
+        ]),
+
+        NormalizationRule: f.form('Normalization Rule', [
+            f.section('Rule Details', [
+                ...f.text('name', 'Name', true),
+                ...f.textarea('description', 'Description'),
+                ...f.select('status', 'Status', AlmPolicies.enums.POLICY_STATUS),
+                ...f.number('priority', 'Priority (lower runs first)'),
+                ...f.checkbox('stop', 'Stop After Match')
+            ]),
+            f.section('Match', [
+                ...f.select('eventTypeFilter', 'Event Type', enums.EVENT_TYPE),
+                ...f.select('field', 'Field', enums.NORMALIZATION_FIELD),
+                ...f.textarea('pattern', 'Pattern (regex or %{GROK:name})', true)
+            ]),
+            f.section('Set Fields (${capture} templates)', [
+                ...f.text('category', 'Category'),
+                ...f.text('subcategory', 'Subcategory'),
+                ...f.text('nodeName', 'Node Name'),
+                ...f.select('severity', 'Severity', AlmAlarms.enums.ALARM_SEVERITY)
+            ])
         ])
     };
 
@@ -41,6 +41,14 @@ limitations under the License.
                 ...f.number('cooldownSeconds', 'Cooldown (seconds)'),
                 ...f.number('maxNotificationsPerHour', 'Max Notifications/Hour')
             ]),
+            f.section('Conditions', [
+                ...f.inlineTable('conditions', 'Conditions', [
+                    { key: 'conditionId', label: 'ID', type: 'text', hidden: true },
+                    { key: 'field', label: 'Field', type: 'text' },
+                    { key: 'operator', label: 'Operator', type: 'select', options: AlmCorrelation.enums.CONDITION_OPERATOR },
+                    { key: 'value', label: 'Value', type: 'text' }
+                ])
+            ]),
             f.section('Targets', [
                 ...f.inlineTable('targets', 'Notification Targets', [
                     { key: 'targetId', label: 'ID', type: 'text', hidden: true },
@@ -58,6 +66,14 @@ limitations under the License.
                 ...f.select('status', 'Status', enums.POLICY_STATUS),
                 ...f.select('minSeverity', 'Min Severity', AlmAlarms.enums.ALARM_SEVERITY)
             ]),
+            f.section('Conditions', [
+                ...f.inlineTable('conditions', 'Conditions', [
+                    { key: 'conditionId', label: 'ID', type: 'text', hidden: true },
+                    { key: 'field', label: 'Field', type: 'text' },
+                    { key: 'operator', label: 'Operator', type: 'select', options: AlmCorrelation.enums.CONDITION_OPERATOR },
+                    { key: 'value', label: 'Value', type: 'text' }
+                ])
+            ]),
             f.section('Escalation Steps', [
                 ...f.inlineTable('steps', 'Escalation Steps', [
                     { key: 'stepId', label: 'ID', type: 'text', hidden: true },
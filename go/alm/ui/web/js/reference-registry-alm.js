@@ -31,6 +31,10 @@ Layer8DReferenceRegistry.register({
         selectColumns: ['eventId', 'message', 'eventType'],
         displayLabel: 'Event'
     },
+    ...refAlm.simple('TrapMapping', 'mappingId', 'name', 'Trap Mapping'),
+    ...refAlm.simple('WebhookMapping', 'mappingId', 'name', 'Webhook Mapping'),
+    ...refAlm.simple('HeartbeatMonitor', 'monitorId', 'name', 'Heartbeat Monitor'),
+    ...refAlm.simple('NormalizationRule', 'ruleId', 'name', 'Normalization Rule'),
 
     // ========================================
     // ALM - Correlation
@@ -46,5 +50,10 @@ Layer8DReferenceRegistry.register({
     // ========================================
     // ALM - Maintenance
     // ========================================
-    ...refAlm.simple('MaintenanceWindow', 'windowId', 'name', 'Maintenance Window')
+    ...refAlm.simple('MaintenanceWindow', 'windowId', 'name', 'Maintenance Window'),
+
+    // ========================================
+    // ALM - Archive
+    // ========================================
+    ...refAlm.simple('ArchiveRetentionPolicy', 'policyId', 'name', 'Retention Policy')
 });
@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+)
+
+// AlertmanagerService is the webhook receiver of Prometheus Alertmanager.
+// Each alert of a POSTed AlertmanagerWebhook (v4) is posted as an event and
+// a WebhookResponse with the event IDs is returned.
+type AlertmanagerService struct {
+	serviceName string
+	serviceArea byte
+}
+
+func activateAlertmanager(vnic ifs.IVNic) {
+	svc := &AlertmanagerService{}
+	sla := ifs.NewServiceLevelAgreement(svc, AlertmanagerServiceName, ServiceArea, true, nil)
+	sla.SetServiceItem(&alm.AlertmanagerWebhook{})
+	sla.SetServiceItemList(&alm.WebhookResponse{})
+
+	ws := web.New(AlertmanagerServiceName, ServiceArea, 0)
+	ws.AddEndpoint(&alm.AlertmanagerWebhook{}, ifs.POST, &alm.WebhookResponse{})
+	sla.SetWebService(ws)
+
+	vnic.Resources().Services().Activate(sla, vnic)
+}
+
+func (s *AlertmanagerService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	s.serviceName = sla.ServiceName()
+	s.serviceArea = sla.ServiceArea()
+	return nil
+}
+
+func (s *AlertmanagerService) DeActivate() error { return nil }
+
+// Post ingests the alerts of an Alertmanager notification.
+func (s *AlertmanagerService) Post(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	hook, ok := elements.Element().(*alm.AlertmanagerWebhook)
+	if !ok || hook == nil {
+		return object.NewError("invalid request: expected AlertmanagerWebhook")
+	}
+	if hook.Version != "" && hook.Version != "4" {
+		return object.NewError("unsupported Alertmanager webhook version " + hook.Version)
+	}
+	resp, err := postEvents(alertmanagerEvents(hook), vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, resp)
+}
+
+func (s *AlertmanagerService) Get(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("alertmanager webhook service only supports POST")
+}
+
+func (s *AlertmanagerService) Put(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("alertmanager webhook service only supports POST")
+}
+
+func (s *AlertmanagerService) Patch(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("alertmanager webhook service only supports POST")
+}
+
+func (s *AlertmanagerService) Delete(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("alertmanager webhook service only supports POST")
+}
+
+func (s *AlertmanagerService) Failed(elements ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (s *AlertmanagerService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (s *AlertmanagerService) WebService() ifs.IWebService {
+	ws := web.New(s.serviceName, s.serviceArea, 0)
+	ws.AddEndpoint(&alm.AlertmanagerWebhook{}, ifs.POST, &alm.WebhookResponse{})
+	return ws
+}
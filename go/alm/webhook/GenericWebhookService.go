@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"github.com/saichler/l8alarms/go/alm/webhookmappings"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// GenericWebhookService ingests arbitrary JSON webhook payloads. A POSTed
+// GenericWebhook carries the source's JSON document as is in its payload; it
+// is converted to events with its webhook mapping, the events are posted and
+// a WebhookResponse with the event IDs is returned.
+type GenericWebhookService struct {
+	serviceName string
+	serviceArea byte
+}
+
+func activateGeneric(vnic ifs.IVNic) {
+	svc := &GenericWebhookService{}
+	sla := ifs.NewServiceLevelAgreement(svc, GenericServiceName, ServiceArea, true, nil)
+	sla.SetServiceItem(&alm.GenericWebhook{})
+	sla.SetServiceItemList(&alm.WebhookResponse{})
+
+	ws := web.New(GenericServiceName, ServiceArea, 0)
+	ws.AddEndpoint(&alm.GenericWebhook{}, ifs.POST, &alm.WebhookResponse{})
+	sla.SetWebService(ws)
+
+	vnic.Resources().Services().Activate(sla, vnic)
+}
+
+func (s *GenericWebhookService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	s.serviceName = sla.ServiceName()
+	s.serviceArea = sla.ServiceArea()
+	return nil
+}
+
+func (s *GenericWebhookService) DeActivate() error { return nil }
+
+// Post ingests a JSON payload with its webhook mapping.
+func (s *GenericWebhookService) Post(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	hook, ok := elements.Element().(*alm.GenericWebhook)
+	if !ok || hook == nil {
+		return object.NewError("invalid request: expected GenericWebhook")
+	}
+	if hook.MappingId == "" {
+		return object.NewError("mappingId is required")
+	}
+	mapping, err := webhookmappings.WebhookMapping(hook.MappingId, vnic)
+	if err != nil {
+		return object.NewError("failed to get webhook mapping: " + err.Error())
+	}
+	if mapping == nil || mapping.Status != alm.AlmPolicyStatus_ALM_POLICY_STATUS_ACTIVE {
+		return object.NewError("no active webhook mapping " + hook.MappingId)
+	}
+	if hook.Payload == nil {
+		return object.NewError("payload is required")
+	}
+	payload, err := protojson.Marshal(hook.Payload)
+	if err != nil {
+		return object.NewError("invalid JSON payload: " + err.Error())
+	}
+	events, err := genericEvents(mapping, payload)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	resp, err := postEvents(events, vnic)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	return object.New(nil, resp)
+}
+
+func (s *GenericWebhookService) Get(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("generic webhook service only supports POST")
+}
+
+func (s *GenericWebhookService) Put(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("generic webhook service only supports POST")
+}
+
+func (s *GenericWebhookService) Patch(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("generic webhook service only supports POST")
+}
+
+func (s *GenericWebhookService) Delete(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("generic webhook service only supports POST")
+}
+
+func (s *GenericWebhookService) Failed(elements ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (s *GenericWebhookService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (s *GenericWebhookService) WebService() ifs.IWebService {
+	ws := web.New(s.serviceName, s.serviceArea, 0)
+	ws.AddEndpoint(&alm.GenericWebhook{}, ifs.POST, &alm.WebhookResponse{})
+	return ws
+}
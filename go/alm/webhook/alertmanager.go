@@ -0,0 +1,130 @@
+package webhook
+
+import (
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/alm/ingest"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"net"
+	"sort"
+	"time"
+)
+
+// alertmanagerCategory is the category of events received from Alertmanager.
+const alertmanagerCategory = "alertmanager"
+
+// alertmanagerEvents converts the alerts of an Alertmanager v4 payload to
+// events, with the node label as node_name, or the receiver or
+// ALERTMANAGER_DEFAULT_NODE when no node label is set. The fingerprint is the
+// source_identifier, so repeated deliveries of the same alert share a dedup
+// key, and labels become attributes.
+func alertmanagerEvents(hook *alm.AlertmanagerWebhook) []*alm.Event {
+	result := make([]*alm.Event, 0, len(hook.Alerts))
+	for _, alert := range hook.Alerts {
+		result = append(result, alertmanagerEvent(hook, alert))
+	}
+	return result
+}
+
+func alertmanagerEvent(hook *alm.AlertmanagerWebhook, alert *alm.AlertmanagerAlert) *alm.Event {
+	labels := merged(hook.CommonLabels, alert.Labels)
+	annotations := merged(hook.CommonAnnotations, alert.Annotations)
+	resolved := alert.Status == "resolved" || (alert.Status == "" && hook.Status == "resolved")
+
+	alertName := labels["alertname"]
+	text := annotations["summary"]
+	if text == "" {
+		text = annotations["description"]
+	}
+	if text == "" {
+		text = alertName
+	} else if alertName != "" {
+		text = alertName + ": " + text
+	}
+
+	severity := ingest.ParseSeverity(labels["severity"])
+	if severity == l8events.Severity_SEVERITY_UNSPECIFIED {
+		severity = l8events.Severity_SEVERITY_WARNING
+	}
+
+	event := &alm.Event{
+		EventType:        alm.AlmEventType_ALM_EVENT_TYPE_THRESHOLD,
+		SourceIdentifier: alert.Fingerprint,
+		Severity:         severity,
+		Message:          statusMessage(resolved, text),
+		Category:         alertmanagerCategory,
+		Subcategory:      alertName,
+	}
+	occurred := alert.StartsAt
+	if resolved {
+		occurred = alert.EndsAt
+	}
+	if t, err := time.Parse(time.RFC3339, occurred); err == nil && t.Unix() > 0 {
+		event.OccurredAt = t.Unix()
+	}
+
+	event.NodeName = nodeLabel(labels)
+	if event.NodeName == "" {
+		event.NodeName = hook.Receiver
+	}
+	if event.NodeName == "" {
+		event.NodeName = common.ALERTMANAGER_DEFAULT_NODE
+	}
+
+	status := "firing"
+	if resolved {
+		status = "resolved"
+	}
+	attrs := []*alm.EventAttribute{{Key: "status", Value: status}}
+	if hook.Receiver != "" {
+		attrs = append(attrs, &alm.EventAttribute{Key: "receiver", Value: hook.Receiver})
+	}
+	if alert.GeneratorUrl != "" {
+		attrs = append(attrs, &alm.EventAttribute{Key: "generatorURL", Value: alert.GeneratorUrl})
+	}
+	for _, k := range sortedKeys(labels) {
+		attrs = append(attrs, &alm.EventAttribute{Key: k, Value: labels[k]})
+	}
+	for _, k := range sortedKeys(annotations) {
+		attrs = append(attrs, &alm.EventAttribute{Key: "annotation." + k, Value: annotations[k]})
+	}
+	event.Attributes = attrs
+	return event
+}
+
+// nodeLabel returns the first of common.ALERTMANAGER_NODE_LABELS set on the
+// alert, without the port of a host:port instance.
+func nodeLabel(labels map[string]string) string {
+	for _, name := range common.ALERTMANAGER_NODE_LABELS {
+		value := labels[name]
+		if value == "" {
+			continue
+		}
+		if host, _, err := net.SplitHostPort(value); err == nil {
+			return host
+		}
+		return value
+	}
+	return ""
+}
+
+// merged returns the group-wide values overlaid with the alert's own.
+func merged(group, own map[string]string) map[string]string {
+	result := make(map[string]string, len(group)+len(own))
+	for k, v := range group {
+		result[k] = v
+	}
+	for k, v := range own {
+		result[k] = v
+	}
+	return result
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,160 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/alm/ingest"
+	"github.com/saichler/l8alarms/go/alm/jsonpath"
+	"github.com/saichler/l8alarms/go/types/alm"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Firing and resolved alerts are told apart by the prefix of their event
+// message, so an alarm definition raises on "^\[FIRING\] ..." and clears
+// on "^\[RESOLVED\] ..." through its clear_event_pattern.
+const (
+	FiringPrefix   = "[FIRING] "
+	ResolvedPrefix = "[RESOLVED] "
+)
+
+func statusMessage(resolved bool, text string) string {
+	if resolved {
+		return ResolvedPrefix + text
+	}
+	return FiringPrefix + text
+}
+
+// genericEvents converts a JSON payload to events with a webhook mapping.
+func genericEvents(mapping *alm.WebhookMapping, payload []byte) ([]*alm.Event, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %w", err)
+	}
+
+	items := []interface{}{doc}
+	if path := strings.TrimSpace(mapping.EventsPath); path != "" && path != "$" {
+		selected, err := jsonpath.Select(path, doc)
+		if err != nil {
+			return nil, fmt.Errorf("eventsPath: %w", err)
+		}
+		items = selected
+	}
+
+	result := make([]*alm.Event, 0, len(items))
+	for _, item := range items {
+		event, err := genericEvent(mapping, item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, event)
+	}
+	return result, nil
+}
+
+func genericEvent(mapping *alm.WebhookMapping, item interface{}) (*alm.Event, error) {
+	var err error
+	get := func(name, path string) string {
+		if err != nil {
+			return ""
+		}
+		value, selectErr := jsonpath.SelectString(path, item)
+		if selectErr != nil {
+			err = fmt.Errorf("%s: %w", name, selectErr)
+		}
+		return value
+	}
+	node := get("nodePath", mapping.NodePath)
+	text := get("messagePath", mapping.MessagePath)
+	severityValue := get("severityPath", mapping.SeverityPath)
+	category := get("categoryPath", mapping.CategoryPath)
+	subcategory := get("subcategoryPath", mapping.SubcategoryPath)
+	source := get("sourcePath", mapping.SourcePath)
+	occurredAt := get("occurredAtPath", mapping.OccurredAtPath)
+	status := get("statusPath", mapping.StatusPath)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := false
+	for _, v := range mapping.ResolvedValues {
+		if status != "" && strings.EqualFold(status, v) {
+			resolved = true
+		}
+	}
+
+	severity := ingest.ParseSeverity(severityValue)
+	if severity == l8events.Severity_SEVERITY_UNSPECIFIED {
+		severity = mapping.DefaultSeverity
+	}
+	if severity == l8events.Severity_SEVERITY_UNSPECIFIED {
+		severity = l8events.Severity_SEVERITY_WARNING
+	}
+	if category == "" {
+		category = mapping.Category
+	}
+	if text == "" {
+		text = mapping.Name
+	}
+	if node == "" {
+		node = common.WEBHOOK_DEFAULT_NODE
+	}
+	eventType := mapping.EventType
+	if eventType == alm.AlmEventType_ALM_EVENT_TYPE_UNSPECIFIED {
+		eventType = alm.AlmEventType_ALM_EVENT_TYPE_CUSTOM
+	}
+
+	event := &alm.Event{
+		EventType:        eventType,
+		NodeName:         node,
+		SourceIdentifier: source,
+		Severity:         severity,
+		Message:          statusMessage(resolved, text),
+		Category:         category,
+		Subcategory:      subcategory,
+		OccurredAt:       parseTime(occurredAt),
+	}
+	raw, _ := json.Marshal(item)
+	event.RawData = string(raw)
+
+	attrs := []*alm.EventAttribute{{Key: "webhookMapping", Value: mapping.MappingId}}
+	if status != "" {
+		attrs = append(attrs, &alm.EventAttribute{Key: "status", Value: status})
+	}
+	for _, k := range sortedKeys(mapping.AttributePaths) {
+		value, err := jsonpath.SelectString(mapping.AttributePaths[k], item)
+		if err != nil {
+			return nil, fmt.Errorf("attributePaths[%s]: %w", k, err)
+		}
+		if value != "" {
+			attrs = append(attrs, &alm.EventAttribute{Key: k, Value: value})
+		}
+	}
+	event.Attributes = attrs
+	return event, nil
+}
+
+// parseTime parses unix seconds, unix milliseconds or an RFC 3339 time to
+// unix seconds; 0 if the value is empty or not a time.
+func parseTime(value string) int64 {
+	if value == "" {
+		return 0
+	}
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		// Unix milliseconds are beyond any plausible time in seconds
+		if n > 1e11 {
+			return int64(n / 1000)
+		}
+		return int64(n)
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.Unix()
+	}
+	return 0
+}
@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/ingest"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+// resolver maps the node names of webhook events to topology nodes; shared
+// by both webhook services.
+var resolver = ingest.NewNodeResolver()
+
+// postEvents resolves the node of each event and posts it for processing.
+// Events are posted in payload order, so a delivery that fails midway can be
+// retried by the sender; the events already posted dedup by their source.
+func postEvents(events []*alm.Event, vnic ifs.IVNic) (*alm.WebhookResponse, error) {
+	resp := &alm.WebhookResponse{}
+	for _, event := range events {
+		if event.NodeName != "" {
+			event.NodeId = resolver.Resolve(event.NodeName, vnic)
+		}
+		if err := ingest.PostEvent(event, vnic); err != nil {
+			return resp, fmt.Errorf("failed to post event %d of %d: %w", len(resp.EventIds)+1, len(events), err)
+		}
+		resp.EventIds = append(resp.EventIds, event.EventId)
+	}
+	return resp, nil
+}
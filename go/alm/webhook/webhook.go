@@ -0,0 +1,16 @@
+package webhook
+
+import "github.com/saichler/l8types/go/ifs"
+
+const (
+	AlertmanagerServiceName = "AlertMgr"
+	GenericServiceName      = "Webhook"
+	ServiceArea             = byte(10)
+)
+
+// Activate starts the webhook ingestion endpoints: /alm/10/AlertMgr for
+// Alertmanager notifications and /alm/10/Webhook for generic JSON payloads.
+func Activate(vnic ifs.IVNic) {
+	activateAlertmanager(vnic)
+	activateGeneric(vnic)
+}
@@ -0,0 +1,31 @@
+package webhookmappings
+
+import (
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+const (
+	ServiceName = "WebhkMap"
+	ServiceArea = byte(10)
+)
+
+func Activate(creds, dbname string, vnic ifs.IVNic) {
+	common.ActivateService(common.ServiceConfig{
+		ServiceName: ServiceName, ServiceArea: ServiceArea,
+		PrimaryKey: "MappingId", Callback: newWebhookMappingServiceCallback(vnic),
+	}, &alm.WebhookMapping{}, &alm.WebhookMappingList{}, creds, dbname, vnic)
+}
+
+func WebhookMappings(vnic ifs.IVNic) (ifs.IServiceHandler, bool) {
+	return common.ServiceHandler(ServiceName, ServiceArea, vnic)
+}
+
+func WebhookMapping(id string, vnic ifs.IVNic) (*alm.WebhookMapping, error) {
+	result, err := common.GetEntity(ServiceName, ServiceArea, &alm.WebhookMapping{MappingId: id}, vnic)
+	if err != nil || result == nil {
+		return nil, err
+	}
+	return result.(*alm.WebhookMapping), nil
+}
@@ -0,0 +1,49 @@
+package webhookmappings
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/jsonpath"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8common/go/common"
+	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+)
+
+func newWebhookMappingServiceCallback(vnic ifs.IVNic) ifs.IServiceCallback {
+	return common.NewValidation(&alm.WebhookMapping{}, vnic).
+		Require(func(e interface{}) string { return e.(*alm.WebhookMapping).MappingId }, "MappingId").
+		Require(func(e interface{}) string { return e.(*alm.WebhookMapping).Name }, "Name").
+		Require(func(e interface{}) string { return e.(*alm.WebhookMapping).MessagePath }, "MessagePath").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.WebhookMapping).Status) }, alm.AlmPolicyStatus_name, "Status").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.WebhookMapping).DefaultSeverity) }, l8events.Severity_name, "DefaultSeverity").
+		Enum(func(e interface{}) int32 { return int32(e.(*alm.WebhookMapping).EventType) }, alm.AlmEventType_name, "EventType").
+		BeforeAction(validatePaths).
+		Build()
+}
+
+// validatePaths rejects mappings with a path the webhook service can't evaluate.
+func validatePaths(mapping *alm.WebhookMapping, action ifs.Action, vnic ifs.IVNic) error {
+	if action != ifs.POST && action != ifs.PUT {
+		return nil
+	}
+	paths := [][2]string{
+		{"EventsPath", mapping.EventsPath},
+		{"NodePath", mapping.NodePath},
+		{"MessagePath", mapping.MessagePath},
+		{"SeverityPath", mapping.SeverityPath},
+		{"CategoryPath", mapping.CategoryPath},
+		{"SubcategoryPath", mapping.SubcategoryPath},
+		{"SourcePath", mapping.SourcePath},
+		{"OccurredAtPath", mapping.OccurredAtPath},
+		{"StatusPath", mapping.StatusPath},
+	}
+	for key, path := range mapping.AttributePaths {
+		paths = append(paths, [2]string{"AttributePaths[" + key + "]", path})
+	}
+	for _, p := range paths {
+		if _, err := jsonpath.Compile(p[1]); err != nil {
+			return fmt.Errorf("%s: %v", p[0], err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,345 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/alarmactions"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/tests/mocks"
+	"github.com/saichler/l8alarms/go/types/alm"
+	"github.com/saichler/l8types/go/ifs"
+	"sync"
+	"testing"
+	"time"
+)
+
+func testAlarmActions(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	testAlarmActionEndpoint(t, client)
+	testAlarmBulkAction(t, client)
+	testAlarmActionConcurrency(t, client, vnic)
+}
+
+// postTestAlarm posts an alarm on the node in the given state, with any
+// extra fields, and returns its ID. A CLEARED alarm is cleared now by
+// "tester" unless the extra fields say otherwise.
+func postTestAlarm(t *testing.T, client *mocks.Client, nodeId string, state int, extra map[string]interface{}) string {
+	alarmId := ifs.NewUuid()
+	alarm := map[string]interface{}{
+		"alarm_id":      alarmId,
+		"definition_id": testStore.DefinitionIDs[0],
+		"node_id":       nodeId,
+		"name":          "testAlarm",
+		"state":         state,
+		"severity":      2, // WARNING
+	}
+	if state == 3 { // CLEARED
+		alarm["cleared_at"] = time.Now().Unix()
+		alarm["cleared_by"] = "tester"
+	}
+	for k, v := range extra {
+		alarm[k] = v
+	}
+	if _, err := client.Post("/alm/10/Alarm", alarm); err != nil {
+		t.Fatalf("POST test alarm failed: %v", err)
+	}
+	return alarmId
+}
+
+// postAlarmAction posts an AlarmActionRequest and returns the updated alarms by ID.
+func postAlarmAction(t *testing.T, client *mocks.Client, req map[string]interface{}) map[string]map[string]interface{} {
+	resp, err := client.Post("/alm/10/AlmAction", req)
+	if err != nil {
+		t.Fatalf("POST AlarmActionRequest %v failed: %v", req["action"], err)
+	}
+	var wrapper struct {
+		Alarms []map[string]interface{} `json:"alarms"`
+	}
+	if err := json.Unmarshal([]byte(resp), &wrapper); err != nil {
+		t.Fatalf("Failed to parse AlarmActionResponse: %v", err)
+	}
+	result := make(map[string]map[string]interface{})
+	for _, alarm := range wrapper.Alarms {
+		id, _ := alarm["alarmId"].(string)
+		result[id] = alarm
+	}
+	return result
+}
+
+// postBulkAction posts a query AlarmActionRequest and returns the response
+// alarms and the per-alarm results by ID.
+func postBulkAction(t *testing.T, client *mocks.Client, req map[string]interface{}) (map[string]map[string]interface{}, map[string]map[string]interface{}) {
+	resp, err := client.Post("/alm/10/AlmAction", req)
+	if err != nil {
+		t.Fatalf("POST query AlarmActionRequest %v failed: %v", req["action"], err)
+	}
+	var wrapper struct {
+		Alarms  []map[string]interface{} `json:"alarms"`
+		Results []map[string]interface{} `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(resp), &wrapper); err != nil {
+		t.Fatalf("Failed to parse AlarmActionResponse: %v", err)
+	}
+	alarms := make(map[string]map[string]interface{})
+	for _, alarm := range wrapper.Alarms {
+		id, _ := alarm["alarmId"].(string)
+		alarms[id] = alarm
+	}
+	results := make(map[string]map[string]interface{})
+	for _, result := range wrapper.Results {
+		id, _ := result["alarmId"].(string)
+		results[id] = result
+	}
+	return alarms, results
+}
+
+// getAlarmState returns the stored state of an alarm.
+func getAlarmState(t *testing.T, client *mocks.Client, alarmId string) float64 {
+	getResp, err := client.Get("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId)))
+	if err != nil {
+		t.Fatalf("GET alarm failed: %v", err)
+	}
+	alarm, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse alarm response: %v", err)
+	}
+	state, _ := alarm["state"].(float64)
+	return state
+}
+
+// lastStateChange returns the last state_history entry of an alarm.
+func lastStateChange(t *testing.T, alarm map[string]interface{}) map[string]interface{} {
+	history, _ := alarm["stateHistory"].([]interface{})
+	if len(history) == 0 {
+		t.Fatalf("Expected state history on alarm %v", alarm["alarmId"])
+	}
+	entry, _ := history[len(history)-1].(map[string]interface{})
+	return entry
+}
+
+// testAlarmActionEndpoint verifies acknowledge, unacknowledge, assign, add
+// note and clear through the AlmAction service, and that a request with an
+// invalid transition changes none of its alarms.
+func testAlarmActionEndpoint(t *testing.T, client *mocks.Client) {
+	first := postTestAlarm(t, client, "node-action-01", 1, nil)
+	second := postTestAlarm(t, client, "node-action-02", 1, nil)
+	ids := []string{first, second}
+	defer func() {
+		for _, id := range ids {
+			client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", id)))
+		}
+	}()
+
+	if _, err := client.Post("/alm/10/AlmAction", map[string]interface{}{"alarm_ids": ids, "action": 1}); err == nil {
+		t.Fatal("Expected an AlarmActionRequest without user to be rejected")
+	}
+	if _, err := client.Post("/alm/10/AlmAction", map[string]interface{}{"alarm_ids": ids, "action": 5, "user": "alice"}); err == nil {
+		t.Fatal("Expected ADD_NOTE without a note to be rejected")
+	}
+
+	// Acknowledge both
+	updated := postAlarmAction(t, client, map[string]interface{}{
+		"alarm_ids": ids, "action": 1, "user": "alice", "reason": "investigating",
+	})
+	for _, id := range ids {
+		alarm := updated[id]
+		if alarm == nil {
+			t.Fatalf("Expected alarm %s in the response", id)
+		}
+		if state, _ := alarm["state"].(float64); state != 2 || alarm["acknowledgedBy"] != "alice" {
+			t.Fatalf("Expected %s ACKNOWLEDGED by alice, got state=%v by=%v", id, alarm["state"], alarm["acknowledgedBy"])
+		}
+		if entry := lastStateChange(t, alarm); entry["changedBy"] != "alice" || entry["reason"] != "[operator] investigating" {
+			t.Fatalf("Unexpected state history entry: %v", entry)
+		}
+	}
+
+	// Acknowledging again fails for both, leaving them unchanged
+	if _, err := client.Post("/alm/10/AlmAction", map[string]interface{}{"alarm_ids": ids, "action": 1, "user": "bob"}); err == nil {
+		t.Fatal("Expected acknowledging an acknowledged alarm to be rejected")
+	}
+
+	// Unacknowledge the first
+	updated = postAlarmAction(t, client, map[string]interface{}{
+		"alarm_ids": []string{first}, "action": 2, "user": "bob",
+	})
+	if state, _ := updated[first]["state"].(float64); state != 1 || updated[first]["acknowledgedBy"] != nil {
+		t.Fatalf("Expected unacknowledged alarm ACTIVE without acknowledgedBy, got state=%v by=%v",
+			updated[first]["state"], updated[first]["acknowledgedBy"])
+	}
+
+	// Assign with a note, then add a note
+	updated = postAlarmAction(t, client, map[string]interface{}{
+		"alarm_ids": []string{second}, "action": 4, "user": "alice", "assignee": "noc-team", "note": "handing over",
+	})
+	if updated[second]["assignedTo"] != "noc-team" {
+		t.Fatalf("Expected assignedTo=noc-team, got=%v", updated[second]["assignedTo"])
+	}
+	updated = postAlarmAction(t, client, map[string]interface{}{
+		"alarm_ids": []string{second}, "action": 5, "user": "carol", "note": "vendor ticket opened",
+	})
+	notes, _ := updated[second]["notes"].([]interface{})
+	if len(notes) != 2 {
+		t.Fatalf("Expected 2 notes, got=%d", len(notes))
+	}
+	if note, _ := notes[1].(map[string]interface{}); note["author"] != "carol" || note["text"] != "vendor ticket opened" {
+		t.Fatalf("Unexpected note: %v", notes[1])
+	}
+
+	// Clear both; the stored alarms match the response
+	postAlarmAction(t, client, map[string]interface{}{
+		"alarm_ids": ids, "action": 3, "user": "alice", "reason": "fixed",
+	})
+	for _, id := range ids {
+		getResp, err := client.Get("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", id)))
+		if err != nil {
+			t.Fatalf("GET alarm failed: %v", err)
+		}
+		alarm, err := extractFirstFromList(getResp)
+		if err != nil {
+			t.Fatalf("Failed to parse alarm response: %v", err)
+		}
+		if state, _ := alarm["state"].(float64); state != 3 || alarm["clearedBy"] != "alice" {
+			t.Fatalf("Expected %s CLEARED by alice, got state=%v by=%v", id, alarm["state"], alarm["clearedBy"])
+		}
+	}
+}
+
+// testAlarmBulkAction verifies acting on the alarms matching a query: a dry
+// run writes nothing, each alarm succeeds or fails on its own, and a query
+// matching more than ALARM_ACTION_MAX_ALARMS is rejected.
+func testAlarmBulkAction(t *testing.T, client *mocks.Client) {
+	node := "node-bulk-01"
+	ids := []string{
+		postTestAlarm(t, client, node, 1, nil),
+		postTestAlarm(t, client, node, 1, nil),
+		postTestAlarm(t, client, node, 1, nil),
+	}
+	acked := ids[0]
+	defer client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where NodeId=%s", node)))
+
+	postAlarmAction(t, client, map[string]interface{}{"alarm_ids": []string{acked}, "action": 1, "user": "alice"})
+	query := fmt.Sprintf("select * from Alarm where NodeId=%s", node)
+
+	if _, err := client.Post("/alm/10/AlmAction", map[string]interface{}{
+		"alarm_ids": ids, "query": query, "action": 1, "user": "bob",
+	}); err == nil {
+		t.Fatal("Expected a request with both alarmIds and query to be rejected")
+	}
+
+	checkResults := func(alarms, results map[string]map[string]interface{}) {
+		if len(results) != len(ids) {
+			t.Fatalf("Expected %d results, got=%d", len(ids), len(results))
+		}
+		for _, id := range ids {
+			result := results[id]
+			if result == nil {
+				t.Fatalf("Expected a result for alarm %s", id)
+			}
+			if id == acked {
+				if result["success"] == true || result["error"] == nil || alarms[id] != nil {
+					t.Fatalf("Expected acknowledging acknowledged alarm %s to fail, got=%v", id, result)
+				}
+				continue
+			}
+			if result["success"] != true || alarms[id] == nil {
+				t.Fatalf("Expected acknowledging alarm %s to succeed, got=%v", id, result)
+			}
+		}
+	}
+
+	// Dry run reports the outcome and writes nothing
+	alarms, results := postBulkAction(t, client, map[string]interface{}{
+		"query": query, "action": 1, "user": "bob", "reason": "outage", "dry_run": true,
+	})
+	checkResults(alarms, results)
+	for _, id := range ids[1:] {
+		if state := getAlarmState(t, client, id); state != 1 {
+			t.Fatalf("Expected dry run to leave alarm %s ACTIVE, got state=%v", id, state)
+		}
+	}
+
+	// Acknowledge by query; the acknowledged alarm fails alone
+	alarms, results = postBulkAction(t, client, map[string]interface{}{
+		"query": query, "action": 1, "user": "bob", "reason": "outage",
+	})
+	checkResults(alarms, results)
+	for _, id := range ids[1:] {
+		if state := getAlarmState(t, client, id); state != 2 {
+			t.Fatalf("Expected alarm %s ACKNOWLEDGED, got state=%v", id, state)
+		}
+	}
+
+	// A lower limit of the query's own is kept
+	_, results = postBulkAction(t, client, map[string]interface{}{
+		"query": query + " limit 1", "action": 3, "user": "bob", "dry_run": true,
+	})
+	if len(results) != 1 {
+		t.Fatalf("Expected a query limited to 1 to report 1 result, got=%d", len(results))
+	}
+
+	// A query matching more than the maximum is rejected as a whole, even
+	// with a higher limit of its own
+	saved := common.ALARM_ACTION_MAX_ALARMS
+	common.ALARM_ACTION_MAX_ALARMS = 2
+	_, err := client.Post("/alm/10/AlmAction", map[string]interface{}{"query": query, "action": 3, "user": "bob"})
+	_, limitErr := client.Post("/alm/10/AlmAction", map[string]interface{}{"query": query + " limit 100", "action": 3, "user": "bob"})
+	common.ALARM_ACTION_MAX_ALARMS = saved
+	if err == nil {
+		t.Fatal("Expected a query over the maximum alarms to be rejected")
+	}
+	if limitErr == nil {
+		t.Fatal("Expected a query over the maximum alarms with its own higher limit to be rejected")
+	}
+	for _, id := range ids {
+		if state := getAlarmState(t, client, id); state != 2 {
+			t.Fatalf("Expected rejected request to leave alarm %s ACKNOWLEDGED, got state=%v", id, state)
+		}
+	}
+}
+
+// testAlarmActionConcurrency verifies that concurrent actions on an alarm
+// apply one after the other: every note added at once is kept, and each
+// alarm of a request reports its own result.
+func testAlarmActionConcurrency(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	alarmId := postTestAlarm(t, client, "node-action-concurrent", 1, nil)
+	defer client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId)))
+
+	const writers = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, writers)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := alarmactions.Run(&alm.AlarmActionRequest{
+				AlarmIds: []string{alarmId},
+				Action:   alm.AlarmActionType_ALARM_ACTION_TYPE_ADD_NOTE,
+				User:     fmt.Sprintf("writer-%d", i),
+				Note:     fmt.Sprintf("note %d", i),
+			}, vnic)
+			if err == nil && (len(resp.Results) != 1 || !resp.Results[0].Success) {
+				err = fmt.Errorf("unexpected results %v", resp.Results)
+			}
+			if err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("Concurrent ADD_NOTE failed: %v", err)
+	}
+
+	getResp, err := client.Get("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId)))
+	if err != nil {
+		t.Fatalf("GET alarm failed: %v", err)
+	}
+	alarm, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse alarm response: %v", err)
+	}
+	if notes, _ := alarm["notes"].([]interface{}); len(notes) != writers {
+		t.Fatalf("Expected %d notes from concurrent actions, got=%d", writers, len(notes))
+	}
+}
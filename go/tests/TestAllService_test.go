@@ -112,8 +112,26 @@ func TestAllServices(t *testing.T) {
 	testCRUD(t, client)
 
 	// 9. Test validation
-	testValidation(t, client)
+	testValidation(t, client, erpServicesVnic)
 
 	// 10. Test correlation engine, maintenance windows
 	testCorrelation(t, client)
+
+	// 11. Test event-to-alarm processing
+	testEventProcessing(t, client)
+
+	// 12. Test maintenance window recurrence and lifecycle
+	testMaintenance(t, client, erpServicesVnic)
+
+	// 13. Test persisted escalation state
+	testEscalation(t, client)
+
+	// 14. Test archive action and retention archiving
+	testArchiving(t, client, erpServicesVnic)
+
+	// 15. Test event receivers
+	testIngestion(t, client, erpServicesVnic)
+
+	// 16. Test alarm operation endpoints
+	testAlarmActions(t, client, erpServicesVnic)
 }
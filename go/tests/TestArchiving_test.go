@@ -0,0 +1,514 @@
+package tests
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/archiving"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/tests/mocks"
+	"github.com/saichler/l8types/go/ifs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testArchiving(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	testArchiveAction(t, client)
+	testRetentionArchiving(t, client, vnic)
+	testArchiveUnitOfWork(t, client)
+	testArchiveRestore(t, client)
+	testArchiveRestoreQuiet(t, client)
+	testArchivePurge(t, client, vnic)
+	testArchiveExport(t, client, vnic)
+}
+
+// countEntities returns how many entities the query returns from the endpoint.
+func countEntities(t *testing.T, client *mocks.Client, endpoint, query string) int {
+	getResp, err := client.Get(endpoint, mocks.L8QueryText(query))
+	if err != nil {
+		t.Fatalf("GET %s failed: %v", endpoint, err)
+	}
+	var wrapper map[string]interface{}
+	if err := json.Unmarshal([]byte(getResp), &wrapper); err != nil {
+		t.Fatalf("Failed to parse %s response: %v", endpoint, err)
+	}
+	list, _ := wrapper["list"].([]interface{})
+	return len(list)
+}
+
+// testArchiveAction verifies that posting an ArchiveRequest moves the alarm
+// to ArchivedAlarm, and that a request with neither an ID nor a query is rejected.
+func testArchiveAction(t *testing.T, client *mocks.Client) {
+	alarmId := postTestAlarm(t, client, "node-archive-01", 3, nil)
+
+	if _, err := client.Post("/alm/10/AlmArchive", map[string]interface{}{}); err == nil {
+		t.Fatal("Expected ArchiveRequest without alarmId or query to be rejected")
+	}
+
+	req := map[string]interface{}{"alarm_id": alarmId, "archived_by": "tester"}
+	if _, err := client.Post("/alm/10/AlmArchive", req); err != nil {
+		t.Fatalf("POST ArchiveRequest failed: %v", err)
+	}
+	time.Sleep(1 * time.Second)
+
+	if n := countEntities(t, client, "/alm/10/Alarm", fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId)); n != 0 {
+		t.Fatalf("Expected archived alarm to be removed from Alarm, got=%d", n)
+	}
+	q := mocks.L8QueryText(fmt.Sprintf("select * from ArchivedAlarm where AlarmId=%s", alarmId))
+	getResp, err := client.Get("/alm/10/ArcAlarm", q)
+	if err != nil {
+		t.Fatalf("GET archived alarm failed: %v", err)
+	}
+	archived, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse archived alarm response: %v", err)
+	}
+	if archived["archivedBy"] != "tester" {
+		t.Fatalf("Expected archivedBy=tester, got=%v", archived["archivedBy"])
+	}
+}
+
+// testRetentionArchiving verifies that retention archives CLEARED alarms
+// cleared before the retention age and keeps the recent ones. The 14 day
+// retention keeps the mock alarms, which are at most a week old.
+func testRetentionArchiving(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	now := time.Now().Unix()
+	oldId := postTestAlarm(t, client, "node-retention-01", 3, map[string]interface{}{"cleared_at": now - 30*86400})
+	recentId := postTestAlarm(t, client, "node-retention-02", 3, map[string]interface{}{"cleared_at": now - 60})
+	time.Sleep(1 * time.Second)
+
+	archiving.RunRetention(vnic, 14*86400)
+	time.Sleep(1 * time.Second)
+
+	if n := countEntities(t, client, "/alm/10/ArcAlarm", fmt.Sprintf("select * from ArchivedAlarm where AlarmId=%s", oldId)); n != 1 {
+		t.Fatalf("Expected alarm cleared 30 days ago to be archived, got=%d", n)
+	}
+	if n := countEntities(t, client, "/alm/10/Alarm", fmt.Sprintf("select * from Alarm where AlarmId=%s", recentId)); n != 1 {
+		t.Fatalf("Expected recently cleared alarm to be kept, got=%d", n)
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", recentId)))
+}
+
+// testArchiveUnitOfWork verifies that archiving a root cause moves its
+// symptoms with it, resumes over a copy left by an interrupted attempt, and
+// that a rollback removes the copies of an alarm that is still active.
+func testArchiveUnitOfWork(t *testing.T, client *mocks.Client) {
+	rootId := postTestAlarm(t, client, "node-archive-root", 3, map[string]interface{}{
+		"is_root_cause": true,
+		"symptom_count": 2,
+	})
+	symptomIds := []string{
+		postTestAlarm(t, client, "node-archive-sym-01", 3, map[string]interface{}{"root_cause_alarm_id": rootId}),
+		postTestAlarm(t, client, "node-archive-sym-02", 3, map[string]interface{}{"root_cause_alarm_id": rootId}),
+	}
+
+	// A copy of one symptom left behind by an interrupted archive
+	leftover := map[string]interface{}{
+		"alarm_id":      symptomIds[0],
+		"definition_id": testStore.DefinitionIDs[0],
+		"node_id":       "node-archive-sym-01",
+		"state":         3,
+		"archived_by":   "tester",
+	}
+	if _, err := client.Post("/alm/10/ArcAlarm", leftover); err != nil {
+		t.Fatalf("POST leftover archived alarm failed: %v", err)
+	}
+
+	// Rollback removes the leftover copy while the alarms are still active
+	rollback := map[string]interface{}{"alarm_id": rootId, "rollback": true}
+	if _, err := client.Post("/alm/10/AlmArchive", rollback); err != nil {
+		t.Fatalf("POST rollback ArchiveRequest failed: %v", err)
+	}
+	time.Sleep(1 * time.Second)
+	if n := countEntities(t, client, "/alm/10/ArcAlarm", fmt.Sprintf("select * from ArchivedAlarm where AlarmId=%s", symptomIds[0])); n != 0 {
+		t.Fatalf("Expected rollback to remove the leftover archived copy, got=%d", n)
+	}
+
+	// Leave the copy behind again; archiving the root overwrites it with the live alarm
+	if _, err := client.Post("/alm/10/ArcAlarm", leftover); err != nil {
+		t.Fatalf("POST leftover archived alarm failed: %v", err)
+	}
+	if _, err := client.Post("/alm/10/AlmArchive", map[string]interface{}{"alarm_id": rootId}); err != nil {
+		t.Fatalf("POST ArchiveRequest for root cause failed: %v", err)
+	}
+	time.Sleep(1 * time.Second)
+
+	for _, id := range append([]string{rootId}, symptomIds...) {
+		if n := countEntities(t, client, "/alm/10/Alarm", fmt.Sprintf("select * from Alarm where AlarmId=%s", id)); n != 0 {
+			t.Fatalf("Expected alarm %s to be removed from Alarm, got=%d", id, n)
+		}
+		if n := countEntities(t, client, "/alm/10/ArcAlarm", fmt.Sprintf("select * from ArchivedAlarm where AlarmId=%s", id)); n != 1 {
+			t.Fatalf("Expected exactly one archived copy of alarm %s, got=%d", id, n)
+		}
+	}
+
+	getResp, err := client.Get("/alm/10/ArcAlarm", mocks.L8QueryText(fmt.Sprintf("select * from ArchivedAlarm where AlarmId=%s", symptomIds[0])))
+	if err != nil {
+		t.Fatalf("GET archived symptom failed: %v", err)
+	}
+	archived, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse archived symptom: %v", err)
+	}
+	if archived["name"] != "testAlarm" || archived["archivedBy"] != archiving.ArchivedByDefault ||
+		archived["rootCauseAlarmId"] != rootId {
+		t.Fatalf("Expected the leftover copy to be overwritten with the live alarm, got=%v", archived)
+	}
+}
+
+// testArchiveRestore verifies that restoring an archived root cause brings it
+// back with its symptoms, keeping their links and symptom count, records the
+// restore in state_history, and removes the archive copies.
+func testArchiveRestore(t *testing.T, client *mocks.Client) {
+	rootId := postTestAlarm(t, client, "node-restore-root", 3, map[string]interface{}{
+		"is_root_cause": true,
+		"symptom_count": 1,
+	})
+	symptomId := postTestAlarm(t, client, "node-restore-sym-01", 3, map[string]interface{}{"root_cause_alarm_id": rootId})
+
+	if _, err := client.Post("/alm/10/AlmArchive", map[string]interface{}{"alarm_id": rootId}); err != nil {
+		t.Fatalf("POST ArchiveRequest for root cause failed: %v", err)
+	}
+	time.Sleep(1 * time.Second)
+
+	// Restoring requires an alarm ID
+	if _, err := client.Post("/alm/10/AlmArchive", map[string]interface{}{"query": "select * from Alarm", "restore": true}); err == nil {
+		t.Fatal("Expected restore without alarmId to be rejected")
+	}
+	restore := map[string]interface{}{"alarm_id": rootId, "restore": true, "archived_by": "tester"}
+	if _, err := client.Post("/alm/10/AlmArchive", restore); err != nil {
+		t.Fatalf("POST restore ArchiveRequest failed: %v", err)
+	}
+	time.Sleep(1 * time.Second)
+
+	for _, id := range []string{rootId, symptomId} {
+		if n := countEntities(t, client, "/alm/10/ArcAlarm", fmt.Sprintf("select * from ArchivedAlarm where AlarmId=%s", id)); n != 0 {
+			t.Fatalf("Expected archived copy of alarm %s to be removed, got=%d", id, n)
+		}
+	}
+
+	getResp, err := client.Get("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", rootId)))
+	if err != nil {
+		t.Fatalf("GET restored root cause failed: %v", err)
+	}
+	root, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Expected restored root cause alarm %s: %v", rootId, err)
+	}
+	if n, _ := root["symptomCount"].(float64); n != 1 {
+		t.Fatalf("Expected restored symptomCount=1, got=%v", root["symptomCount"])
+	}
+	history, _ := root["stateHistory"].([]interface{})
+	if len(history) == 0 {
+		t.Fatal("Expected a state_history entry for the restore")
+	}
+	last, _ := history[len(history)-1].(map[string]interface{})
+	if last["reason"] != archiving.RestoreReason || last["changedBy"] != "tester" {
+		t.Fatalf("Expected last state change to record the restore, got=%v", last)
+	}
+
+	getResp, err = client.Get("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", symptomId)))
+	if err != nil {
+		t.Fatalf("GET restored symptom failed: %v", err)
+	}
+	symptom, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Expected restored symptom alarm %s: %v", symptomId, err)
+	}
+	if symptom["rootCauseAlarmId"] != rootId {
+		t.Fatalf("Expected restored symptom to link to %s, got=%v", rootId, symptom["rootCauseAlarmId"])
+	}
+}
+
+// testArchiveRestoreQuiet verifies that a restored ACTIVE alarm is written as
+// is: an open alarm with the same dedup key does not absorb it.
+func testArchiveRestoreQuiet(t *testing.T, client *mocks.Client) {
+	defId := ifs.NewUuid()
+	def := map[string]interface{}{
+		"definition_id":        defId,
+		"name":                 "Restore Dedup Test",
+		"status":               1, // DRAFT
+		"default_severity":     3, // MINOR
+		"dedup_enabled":        true,
+		"dedup_key_expression": "${nodeId}",
+	}
+	if _, err := client.Post("/alm/10/AlmDef", def); err != nil {
+		t.Fatalf("POST restore dedup definition failed: %v", err)
+	}
+	nodeId := "node-restore-dedup"
+	postActive := func() string {
+		alarmId := ifs.NewUuid()
+		alarm := map[string]interface{}{
+			"alarm_id":         alarmId,
+			"definition_id":    defId,
+			"node_id":          nodeId,
+			"state":            1, // ACTIVE
+			"severity":         3, // MINOR
+			"occurrence_count": 1,
+		}
+		if _, err := client.Post("/alm/10/Alarm", alarm); err != nil {
+			t.Fatalf("POST restore dedup alarm failed: %v", err)
+		}
+		return alarmId
+	}
+	defer func() {
+		client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where NodeId=%s", nodeId)))
+		client.Delete("/alm/10/AlmDef", mocks.L8QueryText(fmt.Sprintf("select * from AlarmDefinition where DefinitionId=%s", defId)))
+	}()
+
+	archivedId := postActive()
+	if _, err := client.Post("/alm/10/AlmArchive", map[string]interface{}{"alarm_id": archivedId}); err != nil {
+		t.Fatalf("POST ArchiveRequest failed: %v", err)
+	}
+	time.Sleep(1 * time.Second)
+	openId := postActive()
+
+	if _, err := client.Post("/alm/10/AlmArchive", map[string]interface{}{"alarm_id": archivedId, "restore": true}); err != nil {
+		t.Fatalf("Expected restore next to an open duplicate to succeed: %v", err)
+	}
+	time.Sleep(1 * time.Second)
+	if n := countEntities(t, client, "/alm/10/Alarm", fmt.Sprintf("select * from Alarm where AlarmId=%s", archivedId)); n != 1 {
+		t.Fatalf("Expected alarm %s to be restored, got=%d", archivedId, n)
+	}
+	getResp, err := client.Get("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", openId)))
+	if err != nil {
+		t.Fatalf("GET open alarm failed: %v", err)
+	}
+	open, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse open alarm: %v", err)
+	}
+	if count, _ := open["occurrenceCount"].(float64); count != 1 {
+		t.Fatalf("Expected the restore not to bump the open alarm's occurrenceCount, got=%v", open["occurrenceCount"])
+	}
+}
+
+// postArchivedAlarm posts an archived alarm of the definition, archived at archivedAt.
+func postArchivedAlarm(t *testing.T, client *mocks.Client, definitionId string, severity int, archivedAt int64) string {
+	alarmId := ifs.NewUuid()
+	archived := map[string]interface{}{
+		"alarm_id":      alarmId,
+		"definition_id": definitionId,
+		"node_id":       "node-purge-01",
+		"state":         3, // CLEARED
+		"severity":      severity,
+		"archived_at":   archivedAt,
+		"archived_by":   "tester",
+	}
+	if _, err := client.Post("/alm/10/ArcAlarm", archived); err != nil {
+		t.Fatalf("POST archived alarm failed: %v", err)
+	}
+	return alarmId
+}
+
+// getRetentionPolicy reads back the archive retention policy.
+func getRetentionPolicy(t *testing.T, client *mocks.Client, policyId string) map[string]interface{} {
+	q := mocks.L8QueryText(fmt.Sprintf("select * from ArchiveRetentionPolicy where PolicyId=%s", policyId))
+	getResp, err := client.Get("/alm/10/ArcPolicy", q)
+	if err != nil {
+		t.Fatalf("GET archive retention policy failed: %v", err)
+	}
+	policy, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse archive retention policy response: %v", err)
+	}
+	return policy
+}
+
+// testArchivePurge verifies that a retention policy only reports matches in
+// dry-run mode, and otherwise exports the matched archived alarms to a
+// compressed JSONL file and purges them, keeping other severities.
+func testArchivePurge(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	definitionId := "def-purge-test"
+	old := time.Now().Unix() - 30*86400
+	minorId := postArchivedAlarm(t, client, definitionId, 3, old)    // MINOR
+	criticalId := postArchivedAlarm(t, client, definitionId, 5, old) // CRITICAL
+	common.EXPORT_DIRECTORY = t.TempDir()
+
+	policyId := ifs.NewUuid()
+	policy := map[string]interface{}{
+		"policy_id":            policyId,
+		"name":                 "Purge Test",
+		"status":               1, // ACTIVE
+		"purge_alarms":         true,
+		"max_age_days":         7,
+		"severities":           []int{3}, // MINOR
+		"alarm_definition_ids": []string{definitionId},
+		"dry_run":              true,
+		"export_before_purge":  true,
+		"export_directory":     "purge",
+	}
+
+	// Exports may not leave EXPORT_DIRECTORY
+	policy["export_directory"] = "../escape"
+	if _, err := client.Post("/alm/10/ArcPolicy", policy); err == nil {
+		t.Fatal("Expected retention policy with an exportDirectory outside EXPORT_DIRECTORY to be rejected")
+	}
+	policy["export_directory"] = "purge"
+	policy["policy_id"] = "../../escape"
+	if _, err := client.Post("/alm/10/ArcPolicy", policy); err == nil {
+		t.Fatal("Expected retention policy with a path in its policyId to be rejected")
+	}
+	policy["policy_id"] = policyId
+
+	// A policy without a max age is rejected
+	policy["max_age_days"] = 0
+	if _, err := client.Post("/alm/10/ArcPolicy", policy); err == nil {
+		t.Fatal("Expected retention policy without maxAgeDays to be rejected")
+	}
+	policy["max_age_days"] = 7
+	if _, err := client.Post("/alm/10/ArcPolicy", policy); err != nil {
+		t.Fatalf("POST archive retention policy failed: %v", err)
+	}
+
+	// Dry run reports the match without deleting it
+	archiving.RunPurge(vnic)
+	time.Sleep(1 * time.Second)
+	if n, _ := getRetentionPolicy(t, client, policyId)["lastAlarmCount"].(float64); n != 1 {
+		t.Fatalf("Expected dry run lastAlarmCount=1, got=%v", n)
+	}
+	if n := countEntities(t, client, "/alm/10/ArcAlarm", fmt.Sprintf("select * from ArchivedAlarm where AlarmId=%s", minorId)); n != 1 {
+		t.Fatalf("Expected dry run to keep the archived alarm, got=%d", n)
+	}
+
+	// Real run exports and purges
+	policy["dry_run"] = false
+	if _, err := client.Put("/alm/10/ArcPolicy", policy); err != nil {
+		t.Fatalf("PUT archive retention policy failed: %v", err)
+	}
+	archiving.RunPurge(vnic)
+	time.Sleep(1 * time.Second)
+
+	if n := countEntities(t, client, "/alm/10/ArcAlarm", fmt.Sprintf("select * from ArchivedAlarm where AlarmId=%s", minorId)); n != 0 {
+		t.Fatalf("Expected MINOR archived alarm to be purged, got=%d", n)
+	}
+	if n := countEntities(t, client, "/alm/10/ArcAlarm", fmt.Sprintf("select * from ArchivedAlarm where AlarmId=%s", criticalId)); n != 1 {
+		t.Fatalf("Expected CRITICAL archived alarm to be kept, got=%d", n)
+	}
+
+	exportFile, _ := getRetentionPolicy(t, client, policyId)["lastExportFile"].(string)
+	if filepath.Dir(exportFile) != filepath.Join(common.EXPORT_DIRECTORY, "purge") {
+		t.Fatalf("Expected purge export under EXPORT_DIRECTORY/purge, got %q", exportFile)
+	}
+	f, err := os.Open(exportFile)
+	if err != nil {
+		t.Fatalf("Failed to open purge export %q: %v", exportFile, err)
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("Purge export is not gzip-compressed: %v", err)
+	}
+	var line struct {
+		Kind   string                 `json:"kind"`
+		Record map[string]interface{} `json:"record"`
+	}
+	if err := json.NewDecoder(zr).Decode(&line); err != nil {
+		t.Fatalf("Failed to read purge export line: %v", err)
+	}
+	if line.Kind != "ArchivedAlarm" || line.Record["alarmId"] != minorId {
+		t.Fatalf("Expected export of archived alarm %s, got kind=%s record=%v", minorId, line.Kind, line.Record["alarmId"])
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/ArcPolicy", mocks.L8QueryText(fmt.Sprintf("select * from ArchiveRetentionPolicy where PolicyId=%s", policyId)))
+	archiving.DeleteArchivedAlarm(criticalId, vnic)
+}
+
+// testArchiveExport verifies that archived alarms are exported by node to a
+// CSV file with notes and attributes flattened into columns, and that
+// unsupported formats are rejected.
+func testArchiveExport(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	common.EXPORT_DIRECTORY = t.TempDir()
+	nodeId := "node-export-01"
+	alarmId := ifs.NewUuid()
+	archived := map[string]interface{}{
+		"alarm_id":         alarmId,
+		"definition_id":    "def-export-test",
+		"node_id":          nodeId,
+		"state":            3, // CLEARED
+		"severity":         5, // CRITICAL
+		"first_occurrence": time.Now().Unix() - 3600,
+		"archived_at":      time.Now().Unix(),
+		"archived_by":      "tester",
+		"assigned_to":      "bob",
+		"attributes":       map[string]string{"region": "east", "rack": "r1"},
+		"notes":            []map[string]interface{}{{"author": "ops", "text": "fan replaced"}},
+	}
+	if _, err := client.Post("/alm/10/ArcAlarm", archived); err != nil {
+		t.Fatalf("POST archived alarm failed: %v", err)
+	}
+
+	if _, err := client.Post("/alm/10/ArcExport", map[string]interface{}{"record_type": 1, "format": 0}); err == nil {
+		t.Fatal("Expected export without a format to be rejected")
+	}
+
+	req := map[string]interface{}{
+		"record_type":    1, // ALARM
+		"format":         1, // CSV
+		"node_id":        nodeId,
+		"attribute_keys": []string{"region"},
+	}
+	postResp, err := client.Post("/alm/10/ArcExport", req)
+	if err != nil {
+		t.Fatalf("POST archive export failed: %v", err)
+	}
+	var resp struct {
+		FilePath    string `json:"filePath"`
+		RecordCount int    `json:"recordCount"`
+	}
+	if err := json.Unmarshal([]byte(postResp), &resp); err != nil {
+		t.Fatalf("Failed to parse archive export response: %v", err)
+	}
+	if resp.RecordCount != 1 {
+		t.Fatalf("Expected 1 exported alarm, got=%d", resp.RecordCount)
+	}
+
+	f, err := os.Open(resp.FilePath)
+	if err != nil {
+		t.Fatalf("Failed to open archive export %q: %v", resp.FilePath, err)
+	}
+	defer f.Close()
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to read archive export CSV: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("Expected header and 1 row in archive export, got=%d rows", len(rows))
+	}
+	record := make(map[string]string)
+	for i, name := range rows[0] {
+		record[name] = rows[1][i]
+	}
+	if record["alarm_id"] != alarmId || record["severity"] != "CRITICAL" || record["attr_region"] != "east" {
+		t.Fatalf("Unexpected archive export row: %v", record)
+	}
+	if record["attributes"] != "rack=r1;region=east" || record["note_count"] != "1" {
+		t.Fatalf("Expected flattened attributes and notes, got attributes=%q note_count=%q", record["attributes"], record["note_count"])
+	}
+	if record["assigned_to"] != "bob" {
+		t.Fatalf("Expected assigned_to=bob in archive export, got=%q", record["assigned_to"])
+	}
+
+	// The time range applies to first_occurrence
+	req["from_time"] = time.Now().Unix() - 60
+	postResp, err = client.Post("/alm/10/ArcExport", req)
+	if err != nil {
+		t.Fatalf("POST archive export with time range failed: %v", err)
+	}
+	resp.RecordCount = 0 // omitted from the response when 0
+	if err := json.Unmarshal([]byte(postResp), &resp); err != nil {
+		t.Fatalf("Failed to parse archive export response: %v", err)
+	}
+	if resp.RecordCount != 0 {
+		t.Fatalf("Expected no exported alarm after fromTime, got=%d", resp.RecordCount)
+	}
+
+	// Cleanup
+	archiving.DeleteArchivedAlarm(alarmId, vnic)
+}
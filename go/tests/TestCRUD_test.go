@@ -120,10 +120,11 @@ func testCRUDEvent(t *testing.T, client *mocks.Client) {
 
 	// Events are immutable — no PUT test (see testValidationEventImmutability)
 
+	// Events are only removed by the archiver
 	delQ := mocks.L8QueryText(fmt.Sprintf("select * from Event where EventId=%s", eventId))
 	_, err = client.Delete("/alm/10/Event", delQ)
-	if err != nil {
-		t.Fatalf("DELETE Event failed: %v", err)
+	if err == nil {
+		t.Fatal("DELETE Event should have been rejected (events are immutable)")
 	}
 }
 
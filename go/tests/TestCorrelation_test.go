@@ -3,8 +3,12 @@ package tests
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/saichler/l8alarms/go/alm/correlation"
 	"github.com/saichler/l8alarms/go/tests/mocks"
+	"github.com/saichler/l8alarms/go/types/alm"
 	"github.com/saichler/l8types/go/ifs"
+	l8events "github.com/saichler/l8types/go/types/l8events"
+	"strings"
 	"testing"
 	"time"
 )
@@ -13,6 +17,7 @@ func testCorrelation(t *testing.T, client *mocks.Client) {
 	testPatternCorrelation(t, client)
 	testMaintenanceWindowSuppression(t, client)
 	testNoCorrelationWhenAlreadyCleared(t, client)
+	testAutoSuppressSymptomState(t)
 }
 
 // extractFirstFromList parses a protojson list response and returns the first item.
@@ -178,6 +183,17 @@ func testMaintenanceWindowSuppression(t *testing.T, client *mocks.Client) {
 		t.Fatal("Expected alarm suppressedBy to be set (maintenance:windowId)")
 	}
 
+	// The suppression is recorded in the state history
+	history, _ := result["stateHistory"].([]interface{})
+	if len(history) == 0 {
+		t.Fatal("Expected the suppression in stateHistory")
+	}
+	entry, _ := history[0].(map[string]interface{})
+	if entry["changedBy"] != suppressedBy || !strings.HasPrefix(fmt.Sprint(entry["reason"]), "[maintenance]") {
+		t.Fatalf("Expected suppression by %s from [maintenance], got changedBy=%v reason=%v",
+			suppressedBy, entry["changedBy"], entry["reason"])
+	}
+
 	// Cleanup
 	delQ := mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId))
 	client.Delete("/alm/10/Alarm", delQ)
@@ -224,3 +240,38 @@ func testNoCorrelationWhenAlreadyCleared(t *testing.T, client *mocks.Client) {
 	delQ := mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId))
 	client.Delete("/alm/10/Alarm", delQ)
 }
+
+// testAutoSuppressSymptomState verifies that an auto-suppressing rule moves a
+// symptom posted without a state to SUPPRESSED, and only marks symptoms it
+// could move as suppressed.
+func testAutoSuppressSymptomState(t *testing.T) {
+	rule := &alm.CorrelationRule{
+		RuleId:               "auto-suppress-test",
+		RuleType:             alm.CorrelationRuleType_CORRELATION_RULE_TYPE_PATTERN,
+		Status:               alm.CorrelationRuleStatus_CORRELATION_RULE_STATUS_ACTIVE,
+		RootAlarmPattern:     "powerSupplyFailure",
+		SymptomAlarmPattern:  "tempAboveThreshold",
+		AutoSuppressSymptoms: true,
+	}
+	root := &alm.Alarm{AlarmId: "auto-suppress-root", Name: "powerSupplyFailure",
+		State: l8events.AlarmState_ALARM_STATE_ACTIVE}
+	ctx := &correlation.CorrelationContext{ActiveAlarms: []*alm.Alarm{root}}
+	engine := correlation.NewEngine()
+
+	symptom := &alm.Alarm{AlarmId: "auto-suppress-symptom", Name: "tempAboveThreshold"}
+	if engine.Correlate(symptom, []*alm.CorrelationRule{rule}, ctx) == nil {
+		t.Fatal("Expected the symptom to correlate with the root cause")
+	}
+	if symptom.State != l8events.AlarmState_ALARM_STATE_SUPPRESSED || !symptom.IsSuppressed || symptom.SuppressedBy != root.AlarmId {
+		t.Fatalf("Expected a stateless symptom to be suppressed, got state=%v isSuppressed=%v suppressedBy=%q",
+			symptom.State, symptom.IsSuppressed, symptom.SuppressedBy)
+	}
+
+	cleared := &alm.Alarm{AlarmId: "auto-suppress-cleared", Name: "tempAboveThreshold",
+		State: l8events.AlarmState_ALARM_STATE_CLEARED}
+	engine.Correlate(cleared, []*alm.CorrelationRule{rule}, ctx)
+	if cleared.State != l8events.AlarmState_ALARM_STATE_CLEARED || cleared.IsSuppressed || cleared.SuppressedBy != "" {
+		t.Fatalf("Expected a symptom that cannot be suppressed to stay unsuppressed, got state=%v isSuppressed=%v suppressedBy=%q",
+			cleared.State, cleared.IsSuppressed, cleared.SuppressedBy)
+	}
+}
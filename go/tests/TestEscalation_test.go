@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/saichler/l8alarms/go/tests/mocks"
+	"github.com/saichler/l8types/go/ifs"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func testEscalation(t *testing.T, client *mocks.Client) {
+	testEscalationStatePersisted(t, client)
+	testEscalationPerPolicy(t, client)
+}
+
+// postEscalationPolicy creates an ACTIVE escalation policy scoped to a single
+// node, with a 5 and a 15 minute step, and returns its ID.
+func postEscalationPolicy(t *testing.T, client *mocks.Client, nodeId string) string {
+	policyId := ifs.NewUuid()
+	policy := map[string]interface{}{
+		"policy_id": policyId,
+		"name":      "Escalation Test " + nodeId,
+		"status":    1, // ACTIVE
+		"steps": []map[string]interface{}{
+			{"step_id": ifs.NewUuid(), "step_order": 1, "delay_minutes": 5, "channel": 1, "endpoint": "l1@example.com"},
+			{"step_id": ifs.NewUuid(), "step_order": 2, "delay_minutes": 15, "channel": 1, "endpoint": "l2@example.com"},
+		},
+		"conditions": []map[string]interface{}{
+			{"field": "nodeId", "operator": 1, "value": nodeId},
+		},
+	}
+	if _, err := client.Post("/alm/10/EscPolicy", policy); err != nil {
+		t.Fatalf("POST escalation policy failed: %v", err)
+	}
+	return policyId
+}
+
+// getEscalationStates returns the persisted escalation states of an alarm.
+func getEscalationStates(t *testing.T, client *mocks.Client, alarmId string) []interface{} {
+	q := mocks.L8QueryText(fmt.Sprintf("select * from EscalationState where AlarmId=%s", alarmId))
+	getResp, err := client.Get("/alm/10/EscState", q)
+	if err != nil {
+		t.Fatalf("GET escalation state failed: %v", err)
+	}
+	var wrapper map[string]interface{}
+	if err := json.Unmarshal([]byte(getResp), &wrapper); err != nil {
+		t.Fatalf("Failed to parse escalation state response: %v", err)
+	}
+	list, _ := wrapper["list"].([]interface{})
+	return list
+}
+
+// testEscalationStatePersisted verifies that escalating an alarm persists its
+// progress (policy, next step, next fire time) and that acknowledging the
+// alarm removes it.
+func testEscalationStatePersisted(t *testing.T, client *mocks.Client) {
+	nodeId := "node-escalation-01"
+	policyId := postEscalationPolicy(t, client, nodeId)
+
+	before := time.Now().Unix()
+	alarmId := postTestAlarm(t, client, nodeId, 1, nil)
+	time.Sleep(1 * time.Second)
+	if state := getAlarmState(t, client, alarmId); state != 1 {
+		t.Fatalf("Expected escalation test alarm to be ACTIVE (1), got=%v", state)
+	}
+
+	states := getEscalationStates(t, client, alarmId)
+	if len(states) != 1 {
+		t.Fatalf("Expected 1 escalation state for alarm, got=%d", len(states))
+	}
+	record := states[0].(map[string]interface{})
+	if record["policyId"] != policyId {
+		t.Fatalf("Expected escalation state policyId=%s, got=%v", policyId, record["policyId"])
+	}
+	if step, _ := record["stepIndex"].(float64); step != 0 {
+		t.Fatalf("Expected escalation state stepIndex=0, got=%v", step)
+	}
+	// int64 fields are JSON strings
+	nextFireAt, _ := strconv.ParseInt(fmt.Sprint(record["nextFireAt"]), 10, 64)
+	if nextFireAt < before+300 {
+		t.Fatalf("Expected escalation state nextFireAt >= %d, got=%v", before+300, record["nextFireAt"])
+	}
+
+	// Acknowledge the alarm — escalation is cancelled and its state removed
+	putAlarmState(t, client, alarmId, 2)
+	if states := getEscalationStates(t, client, alarmId); len(states) != 0 {
+		t.Fatalf("Expected escalation state to be removed after acknowledge, got=%d", len(states))
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId)))
+	client.Delete("/alm/10/EscPolicy", mocks.L8QueryText(fmt.Sprintf("select * from EscalationPolicy where PolicyId=%s", policyId)))
+}
+
+// putAlarmState reads the alarm back and PUTs it with the new state.
+func putAlarmState(t *testing.T, client *mocks.Client, alarmId string, state int) {
+	q := mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId))
+	getResp, err := client.Get("/alm/10/Alarm", q)
+	if err != nil {
+		t.Fatalf("GET escalation test alarm failed: %v", err)
+	}
+	alarm, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse escalation test alarm response: %v", err)
+	}
+	alarm["state"] = state
+	if _, err := client.Put("/alm/10/Alarm", alarm); err != nil {
+		t.Fatalf("PUT alarm state=%d failed: %v", state, err)
+	}
+	time.Sleep(1 * time.Second)
+}
+
+// testEscalationPerPolicy verifies that every matching policy escalates the
+// alarm independently, and that escalation restarts from the first step when
+// an acknowledged alarm becomes ACTIVE again.
+func testEscalationPerPolicy(t *testing.T, client *mocks.Client) {
+	nodeId := "node-escalation-02"
+	nocPolicy := postEscalationPolicy(t, client, nodeId)
+	dbaPolicy := postEscalationPolicy(t, client, nodeId)
+
+	alarmId := postTestAlarm(t, client, nodeId, 1, nil)
+	time.Sleep(1 * time.Second)
+	if states := getEscalationStates(t, client, alarmId); len(states) != 2 {
+		t.Fatalf("Expected 1 escalation state per matching policy (2), got=%d", len(states))
+	}
+
+	putAlarmState(t, client, alarmId, 2) // ACKNOWLEDGED
+	if states := getEscalationStates(t, client, alarmId); len(states) != 0 {
+		t.Fatalf("Expected escalation states to be removed after acknowledge, got=%d", len(states))
+	}
+
+	putAlarmState(t, client, alarmId, 1) // ACTIVE again
+	states := getEscalationStates(t, client, alarmId)
+	if len(states) != 2 {
+		t.Fatalf("Expected escalation to restart for both policies (2), got=%d", len(states))
+	}
+	for _, raw := range states {
+		record := raw.(map[string]interface{})
+		if step, _ := record["stepIndex"].(float64); step != 0 {
+			t.Fatalf("Expected restarted escalation at stepIndex=0, got=%v", step)
+		}
+	}
+
+	// Cleanup
+	putAlarmState(t, client, alarmId, 3) // CLEARED
+	client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId)))
+	for _, id := range []string{nocPolicy, dbaPolicy} {
+		client.Delete("/alm/10/EscPolicy", mocks.L8QueryText(fmt.Sprintf("select * from EscalationPolicy where PolicyId=%s", id)))
+	}
+}
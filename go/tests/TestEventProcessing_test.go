@@ -0,0 +1,399 @@
+package tests
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/tests/mocks"
+	"github.com/saichler/l8types/go/ifs"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testEventProcessing(t *testing.T, client *mocks.Client) {
+	testEventRaisesAlarm(t, client)
+	testUnmatchedEventDiscarded(t, client)
+	testThresholdRaising(t, client)
+	testDeduplication(t, client)
+	testClearEvent(t, client)
+}
+
+// postProcessingDefinition creates an ACTIVE alarm definition used only by the
+// event processing tests, so mock data patterns cannot interfere.
+func postProcessingDefinition(t *testing.T, client *mocks.Client, pattern string, thresholdCount int) string {
+	defId := ifs.NewUuid()
+	def := map[string]interface{}{
+		"definition_id":            defId,
+		"name":                     "Processing Test " + pattern,
+		"status":                   2, // ACTIVE
+		"default_severity":         4, // MAJOR
+		"event_pattern":            pattern,
+		"event_type_filter":        1, // TRAP
+		"threshold_count":          thresholdCount,
+		"threshold_window_seconds": 300,
+	}
+	if _, err := client.Post("/alm/10/AlmDef", def); err != nil {
+		t.Fatalf("POST processing test definition failed: %v", err)
+	}
+	return defId
+}
+
+// testEventRaisesAlarm verifies that an event matching an ACTIVE definition
+// raises an alarm and that the event records the processing outcome.
+func testEventRaisesAlarm(t *testing.T, client *mocks.Client) {
+	defId := postProcessingDefinition(t, client, "pipelineTestFault", 1)
+
+	eventId := ifs.NewUuid()
+	event := map[string]interface{}{
+		"event_id":          eventId,
+		"event_type":        1, // TRAP
+		"node_id":           "node-pipeline-01",
+		"source_identifier": "Gi0/1",
+		"message":           "pipelineTestFault detected on Gi0/1",
+	}
+	if _, err := client.Post("/alm/10/Event", event); err != nil {
+		t.Fatalf("POST matching event failed: %v", err)
+	}
+
+	time.Sleep(2 * time.Second)
+
+	q := mocks.L8QueryText(fmt.Sprintf("select * from Event where EventId=%s", eventId))
+	getResp, err := client.Get("/alm/10/Event", q)
+	if err != nil {
+		t.Fatalf("GET processed event failed: %v", err)
+	}
+	result, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse processed event response: %v", err)
+	}
+
+	// Processing state should be PROCESSED (value 2)
+	state, _ := result["processingState"].(float64)
+	if int(state) != 2 {
+		t.Fatalf("Expected event processingState=2 (PROCESSED), got=%v", state)
+	}
+	if d, _ := result["definitionId"].(string); d != defId {
+		t.Fatalf("Expected event definitionId=%s, got=%s", defId, d)
+	}
+	alarmId, _ := result["alarmId"].(string)
+	if alarmId == "" {
+		t.Fatal("Expected event alarmId to be set")
+	}
+
+	// The raised alarm should carry the definition and node of the event
+	q = mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId))
+	getResp, err = client.Get("/alm/10/Alarm", q)
+	if err != nil {
+		t.Fatalf("GET raised alarm failed: %v", err)
+	}
+	alarm, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse raised alarm response: %v", err)
+	}
+	if d, _ := alarm["definitionId"].(string); d != defId {
+		t.Fatalf("Expected alarm definitionId=%s, got=%s", defId, d)
+	}
+	if n, _ := alarm["nodeId"].(string); n != "node-pipeline-01" {
+		t.Fatalf("Expected alarm nodeId=node-pipeline-01, got=%s", n)
+	}
+
+	// Without dedup, a repeat event of the same node and source identifier
+	// updates the open alarm rather than raising another
+	repeat := postProcessingEvent(t, client, "node-pipeline-01", "pipelineTestFault detected on Gi0/1", map[string]interface{}{
+		"source_identifier": "Gi0/1",
+	})
+	if a, _ := repeat["alarmId"].(string); a != alarmId {
+		t.Fatalf("Expected the repeat event to update alarm %s, got alarmId=%s", alarmId, a)
+	}
+	getResp, err = client.Get("/alm/10/Alarm", q)
+	if err != nil {
+		t.Fatalf("GET updated alarm failed: %v", err)
+	}
+	alarm, err = extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse updated alarm response: %v", err)
+	}
+	if count, _ := alarm["occurrenceCount"].(float64); int(count) != 2 {
+		t.Fatalf("Expected occurrenceCount=2 after the repeat event, got=%v", alarm["occurrenceCount"])
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId)))
+	client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where EventId=%s", eventId)))
+	client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where EventId=%s", repeat["eventId"])))
+	client.Delete("/alm/10/AlmDef", mocks.L8QueryText(fmt.Sprintf("select * from AlarmDefinition where DefinitionId=%s", defId)))
+}
+
+// testUnmatchedEventDiscarded verifies that an event matching no definition
+// is marked DISCARDED and does not raise an alarm.
+func testUnmatchedEventDiscarded(t *testing.T, client *mocks.Client) {
+	eventId := ifs.NewUuid()
+	event := map[string]interface{}{
+		"event_id":   eventId,
+		"event_type": 1,
+		"node_id":    "node-pipeline-02",
+		"message":    "noDefinitionMatchesThisMessage",
+	}
+	if _, err := client.Post("/alm/10/Event", event); err != nil {
+		t.Fatalf("POST unmatched event failed: %v", err)
+	}
+
+	time.Sleep(1 * time.Second)
+
+	q := mocks.L8QueryText(fmt.Sprintf("select * from Event where EventId=%s", eventId))
+	getResp, err := client.Get("/alm/10/Event", q)
+	if err != nil {
+		t.Fatalf("GET unmatched event failed: %v", err)
+	}
+	result, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse unmatched event response: %v", err)
+	}
+
+	// Processing state should be DISCARDED (value 3)
+	state, _ := result["processingState"].(float64)
+	if int(state) != 3 {
+		t.Fatalf("Expected event processingState=3 (DISCARDED), got=%v", state)
+	}
+	if alarmId, _ := result["alarmId"].(string); alarmId != "" {
+		t.Fatalf("Unmatched event should not raise an alarm, got alarmId=%s", alarmId)
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where EventId=%s", eventId)))
+}
+
+// postProcessingEvent posts a TRAP event and returns the processed event as read back.
+// Extra fields (e.g. severity, attributes) are merged into the posted event.
+func postProcessingEvent(t *testing.T, client *mocks.Client, nodeId, message string, extra map[string]interface{}) map[string]interface{} {
+	eventId := ifs.NewUuid()
+	event := map[string]interface{}{
+		"event_id":   eventId,
+		"event_type": 1,
+		"node_id":    nodeId,
+		"message":    message,
+	}
+	for k, v := range extra {
+		event[k] = v
+	}
+	if _, err := client.Post("/alm/10/Event", event); err != nil {
+		t.Fatalf("POST event failed: %v", err)
+	}
+
+	time.Sleep(1 * time.Second)
+
+	q := mocks.L8QueryText(fmt.Sprintf("select * from Event where EventId=%s", eventId))
+	getResp, err := client.Get("/alm/10/Event", q)
+	if err != nil {
+		t.Fatalf("GET event failed: %v", err)
+	}
+	result, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse event response: %v", err)
+	}
+	return result
+}
+
+// testThresholdRaising verifies that a definition with threshold_count=3 only
+// raises an alarm on the third matching event within the window.
+func testThresholdRaising(t *testing.T, client *mocks.Client) {
+	defId := postProcessingDefinition(t, client, "thresholdTestFlap", 3)
+	var eventIds []string
+
+	for i := 1; i <= 2; i++ {
+		result := postProcessingEvent(t, client, "node-threshold-01", "thresholdTestFlap on Gi0/2", nil)
+		eventIds = append(eventIds, result["eventId"].(string))
+		if alarmId, _ := result["alarmId"].(string); alarmId != "" {
+			t.Fatalf("Event %d below threshold should not raise an alarm, got alarmId=%s", i, alarmId)
+		}
+		if d, _ := result["definitionId"].(string); d != defId {
+			t.Fatalf("Event %d below threshold should still record definitionId=%s, got=%s", i, defId, d)
+		}
+	}
+
+	result := postProcessingEvent(t, client, "node-threshold-01", "thresholdTestFlap on Gi0/2", nil)
+	eventIds = append(eventIds, result["eventId"].(string))
+	alarmId, _ := result["alarmId"].(string)
+	if alarmId == "" {
+		t.Fatal("Third event should reach the threshold and raise an alarm")
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId)))
+	for _, id := range eventIds {
+		client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where EventId=%s", id)))
+	}
+	client.Delete("/alm/10/AlmDef", mocks.L8QueryText(fmt.Sprintf("select * from AlarmDefinition where DefinitionId=%s", defId)))
+}
+
+// testDeduplication verifies that events with the same dedup key fold into one
+// alarm, and that a duplicate alarm POST is absorbed by the open alarm.
+func testDeduplication(t *testing.T, client *mocks.Client) {
+	defId := ifs.NewUuid()
+	def := map[string]interface{}{
+		"definition_id":           defId,
+		"name":                    "Dedup Test",
+		"status":                  2, // ACTIVE
+		"default_severity":        3, // MINOR
+		"event_pattern":           "dedupTestCrcError",
+		"event_type_filter":       1, // TRAP
+		"dedup_enabled":           true,
+		"dedup_key_expression":    "${nodeId}:${attributes.ifIndex}",
+		"dedup_escalate_severity": true,
+	}
+	if _, err := client.Post("/alm/10/AlmDef", def); err != nil {
+		t.Fatalf("POST dedup test definition failed: %v", err)
+	}
+
+	ifIndex := map[string]interface{}{
+		"severity":   3, // MINOR
+		"attributes": []map[string]interface{}{{"key": "ifIndex", "value": "7"}},
+	}
+	first := postProcessingEvent(t, client, "node-dedup-01", "dedupTestCrcError on ifIndex 7", ifIndex)
+	alarmId, _ := first["alarmId"].(string)
+	if alarmId == "" {
+		t.Fatal("First dedup test event should raise an alarm")
+	}
+
+	ifIndex["severity"] = 4 // MAJOR
+	second := postProcessingEvent(t, client, "node-dedup-01", "dedupTestCrcError on ifIndex 7", ifIndex)
+	if a, _ := second["alarmId"].(string); a != alarmId {
+		t.Fatalf("Repeat event should fold into alarm %s, got alarmId=%s", alarmId, a)
+	}
+
+	q := mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId))
+	getResp, err := client.Get("/alm/10/Alarm", q)
+	if err != nil {
+		t.Fatalf("GET deduplicated alarm failed: %v", err)
+	}
+	alarm, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse deduplicated alarm response: %v", err)
+	}
+	if key, _ := alarm["dedupKey"].(string); key != "node-dedup-01:7" {
+		t.Fatalf("Expected dedupKey=node-dedup-01:7, got=%s", key)
+	}
+	if count, _ := alarm["occurrenceCount"].(float64); int(count) != 2 {
+		t.Fatalf("Expected occurrenceCount=2, got=%v", count)
+	}
+	if sev, _ := alarm["severity"].(float64); int(sev) != 4 {
+		t.Fatalf("Expected severity escalated to 4 (MAJOR), got=%v", sev)
+	}
+
+	// A directly posted alarm with the same dedup key is absorbed, not inserted
+	dupId := ifs.NewUuid()
+	dup := map[string]interface{}{
+		"alarm_id":      dupId,
+		"definition_id": defId,
+		"node_id":       "node-dedup-01",
+		"state":         1, // ACTIVE
+		"severity":      3,
+		"attributes":    map[string]string{"ifIndex": "7"},
+	}
+	_, err = client.Post("/alm/10/Alarm", dup)
+	if err == nil {
+		t.Fatal("POST of a duplicate alarm should be absorbed by the open alarm")
+	}
+	if !strings.Contains(err.Error(), "duplicate alarm:") {
+		t.Fatalf("Expected duplicate alarm error, got: %v", err)
+	}
+
+	// A malformed dedup key expression is rejected
+	bad := map[string]interface{}{
+		"definition_id":        ifs.NewUuid(),
+		"name":                 "Bad Dedup Expression",
+		"status":               1, // DRAFT
+		"dedup_enabled":        true,
+		"dedup_key_expression": "${nodeId",
+	}
+	if _, err := client.Post("/alm/10/AlmDef", bad); err == nil {
+		t.Fatal("POST definition with a malformed dedup key expression should have failed")
+	}
+	// Fixed text would dedup every alarm of the definition into one
+	bad["dedup_key_expression"] = "sameForAll"
+	if _, err := client.Post("/alm/10/AlmDef", bad); err == nil {
+		t.Fatal("POST definition with a dedup key expression without placeholders should have failed")
+	}
+	// The legacy "field+field" form is still accepted
+	legacyId := ifs.NewUuid()
+	bad["definition_id"] = legacyId
+	bad["dedup_key_expression"] = "nodeId+definitionId"
+	if _, err := client.Post("/alm/10/AlmDef", bad); err != nil {
+		t.Fatalf("POST definition with a legacy dedup key expression failed: %v", err)
+	}
+	client.Delete("/alm/10/AlmDef", mocks.L8QueryText(fmt.Sprintf("select * from AlarmDefinition where DefinitionId=%s", legacyId)))
+	if _, err := client.Patch("/alm/10/AlmDef", map[string]interface{}{
+		"definition_id":        defId,
+		"dedup_key_expression": "${nodeId",
+	}); err == nil {
+		t.Fatal("PATCH definition with a malformed dedup key expression should have failed")
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId)))
+	for _, e := range []map[string]interface{}{first, second} {
+		client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where EventId=%s", e["eventId"])))
+	}
+	client.Delete("/alm/10/AlmDef", mocks.L8QueryText(fmt.Sprintf("select * from AlarmDefinition where DefinitionId=%s", defId)))
+}
+
+// testClearEvent verifies that an event matching clear_event_pattern clears the
+// open alarm for the same node and dedup key, recorded as system:auto-clear.
+func testClearEvent(t *testing.T, client *mocks.Client) {
+	defId := ifs.NewUuid()
+	def := map[string]interface{}{
+		"definition_id":       defId,
+		"name":                "Clear Event Test",
+		"status":              2, // ACTIVE
+		"default_severity":    4, // MAJOR
+		"event_pattern":       "clearTestLinkDown",
+		"event_type_filter":   1, // TRAP
+		"auto_clear_enabled":  true,
+		"auto_clear_seconds":  3600,
+		"clear_event_pattern": "clearTestLinkUp",
+		"dedup_enabled":       true,
+	}
+	if _, err := client.Post("/alm/10/AlmDef", def); err != nil {
+		t.Fatalf("POST clear test definition failed: %v", err)
+	}
+
+	port := map[string]interface{}{"source_identifier": "Gi0/3"}
+	down := postProcessingEvent(t, client, "node-clear-01", "clearTestLinkDown Gi0/3", port)
+	alarmId, _ := down["alarmId"].(string)
+	if alarmId == "" {
+		t.Fatal("Link down event should raise an alarm")
+	}
+
+	up := postProcessingEvent(t, client, "node-clear-01", "clearTestLinkUp Gi0/3", port)
+	if a, _ := up["alarmId"].(string); a != alarmId {
+		t.Fatalf("Clear event should reference cleared alarm %s, got alarmId=%s", alarmId, a)
+	}
+
+	q := mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId))
+	getResp, err := client.Get("/alm/10/Alarm", q)
+	if err != nil {
+		t.Fatalf("GET cleared alarm failed: %v", err)
+	}
+	alarm, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse cleared alarm response: %v", err)
+	}
+
+	// State should be CLEARED (value 3)
+	if state, _ := alarm["state"].(float64); int(state) != 3 {
+		t.Fatalf("Expected alarm state=3 (CLEARED), got=%v", state)
+	}
+	if by, _ := alarm["clearedBy"].(string); by != "system:auto-clear" {
+		t.Fatalf("Expected clearedBy=system:auto-clear, got=%s", by)
+	}
+	history, _ := alarm["stateHistory"].([]interface{})
+	if len(history) == 0 {
+		t.Fatal("Expected auto-clear to be recorded in stateHistory")
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId)))
+	for _, e := range []map[string]interface{}{down, up} {
+		client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where EventId=%s", e["eventId"])))
+	}
+	client.Delete("/alm/10/AlmDef", mocks.L8QueryText(fmt.Sprintf("select * from AlarmDefinition where DefinitionId=%s", defId)))
+}
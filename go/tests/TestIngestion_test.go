@@ -0,0 +1,723 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/gosnmp/gosnmp"
+	"github.com/saichler/l8alarms/go/alm/common"
+	"github.com/saichler/l8alarms/go/alm/events"
+	"github.com/saichler/l8alarms/go/alm/snmptrap"
+	"github.com/saichler/l8alarms/go/alm/syslog"
+	"github.com/saichler/l8alarms/go/tests/mocks"
+	"github.com/saichler/l8types/go/ifs"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testIngestion(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	testSnmpTrapReceiver(t, client, vnic)
+	testSyslogReceiver(t, client, vnic)
+	testAlertmanagerWebhook(t, client)
+	testGenericWebhook(t, client)
+	testHeartbeatMonitoring(t, client, vnic)
+	testEventNormalization(t, client)
+}
+
+// getEventsBySource returns the events with the source identifier.
+func getEventsBySource(t *testing.T, client *mocks.Client, sourceIdentifier string) []interface{} {
+	getResp, err := client.Get("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where SourceIdentifier=%s", sourceIdentifier)))
+	if err != nil {
+		t.Fatalf("GET events failed: %v", err)
+	}
+	var wrapper map[string]interface{}
+	if err := json.Unmarshal([]byte(getResp), &wrapper); err != nil {
+		t.Fatalf("Failed to parse events response: %v", err)
+	}
+	list, _ := wrapper["list"].([]interface{})
+	return list
+}
+
+// eventAttribute returns the value of an event attribute, or "" if it is not set.
+func eventAttribute(event map[string]interface{}, key string) string {
+	attrs, _ := event["attributes"].([]interface{})
+	for _, raw := range attrs {
+		attr, _ := raw.(map[string]interface{})
+		if attr["key"] == key {
+			value, _ := attr["value"].(string)
+			return value
+		}
+	}
+	return ""
+}
+
+// testSnmpTrapReceiver verifies that a v2c trap becomes a TRAP event
+// classified by its trap mapping, with varbinds as attributes, and that
+// traps with an unknown community are dropped.
+func testSnmpTrapReceiver(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	mappingId := ifs.NewUuid()
+	mapping := map[string]interface{}{
+		"mapping_id":  mappingId,
+		"name":        "Test Enterprise Traps",
+		"status":      1, // ACTIVE
+		"trap_oid":    "1.3.6.1.4.1.99999",
+		"category":    "hardware",
+		"subcategory": "fan",
+		"severity":    4, // MAJOR
+		"message":     "Fan failure",
+	}
+	if _, err := client.Post("/alm/10/TrapMap", mapping); err != nil {
+		t.Fatalf("POST trap mapping failed: %v", err)
+	}
+
+	port := 16162
+	receiver, err := snmptrap.Start(snmptrap.Config{
+		Address:     fmt.Sprintf("127.0.0.1:%d", port),
+		Communities: []string{"alm-test"},
+	}, vnic)
+	if err != nil {
+		t.Fatalf("Failed to start trap receiver: %v", err)
+	}
+	defer receiver.Close()
+
+	trapOid := ".1.3.6.1.4.1.99999.1.0.7"
+	sendTrap := func(community string) {
+		sender := &gosnmp.GoSNMP{
+			Target:    "127.0.0.1",
+			Port:      uint16(port),
+			Community: community,
+			Version:   gosnmp.Version2c,
+			Timeout:   2 * time.Second,
+		}
+		if err := sender.Connect(); err != nil {
+			t.Fatalf("Failed to connect trap sender: %v", err)
+		}
+		defer sender.Conn.Close()
+		_, err := sender.SendTrap(gosnmp.SnmpTrap{Variables: []gosnmp.SnmpPDU{
+			{Name: ".1.3.6.1.2.1.1.3.0", Type: gosnmp.TimeTicks, Value: uint32(4200)},
+			{Name: ".1.3.6.1.6.3.1.1.4.1.0", Type: gosnmp.ObjectIdentifier, Value: trapOid},
+			{Name: ".1.3.6.1.4.1.99999.2.1", Type: gosnmp.OctetString, Value: "fan-3"},
+		}})
+		if err != nil {
+			t.Fatalf("Failed to send trap: %v", err)
+		}
+	}
+
+	sendTrap("wrong-community")
+	sendTrap("alm-test")
+	time.Sleep(2 * time.Second)
+
+	traps := getEventsBySource(t, client, trapOid)
+	if len(traps) != 1 {
+		t.Fatalf("Expected 1 event for the accepted trap, got=%d", len(traps))
+	}
+	event, _ := traps[0].(map[string]interface{})
+	if eventType, _ := event["eventType"].(float64); eventType != 1 {
+		t.Fatalf("Expected eventType=1 (TRAP), got=%v", event["eventType"])
+	}
+	if event["category"] != "hardware" || event["subcategory"] != "fan" || event["message"] != "Fan failure" {
+		t.Fatalf("Expected event classified by the trap mapping, got category=%v subcategory=%v message=%v",
+			event["category"], event["subcategory"], event["message"])
+	}
+	if severity, _ := event["severity"].(float64); severity != 4 {
+		t.Fatalf("Expected severity=4 (MAJOR), got=%v", event["severity"])
+	}
+	if event["nodeId"] != "127.0.0.1" {
+		t.Fatalf("Expected nodeId to fall back to the agent address, got=%v", event["nodeId"])
+	}
+	if v := eventAttribute(event, ".1.3.6.1.4.1.99999.2.1"); v != "fan-3" {
+		t.Fatalf("Expected varbind attribute fan-3, got=%q", v)
+	}
+	if raw, _ := event["rawData"].(string); raw == "" {
+		t.Fatal("Expected rawData to hold the trap dump")
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where SourceIdentifier=%s", trapOid)))
+	client.Delete("/alm/10/TrapMap", mocks.L8QueryText(fmt.Sprintf("select * from TrapMapping where MappingId=%s", mappingId)))
+}
+
+// testSyslogReceiver verifies that an RFC 3164 message over UDP and an
+// octet-counted RFC 5424 message over TCP become SYSLOG events, that a
+// TCP message larger than a typical read buffer arrives whole, and that idle
+// and surplus TCP connections are closed.
+func testSyslogReceiver(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	address := "127.0.0.1:16514"
+	receiver, err := syslog.Start(syslog.Config{
+		UDPAddress:     address,
+		TCPAddress:     address,
+		MaxMessageSize: 64 * 1024,
+		IdleTimeout:    time.Second,
+		MaxConnections: 1,
+	}, vnic)
+	if err != nil {
+		t.Fatalf("Failed to start syslog receiver: %v", err)
+	}
+	defer receiver.Close()
+
+	suffix := ifs.NewUuid()[:8]
+	udpApp := "almudp" + suffix
+	tcpApp := "almtcp" + suffix
+
+	// <34> = auth.crit
+	udpConn, err := net.Dial("udp", address)
+	if err != nil {
+		t.Fatalf("Failed to dial syslog udp: %v", err)
+	}
+	fmt.Fprintf(udpConn, "<34>Oct 11 22:14:15 sw-core-1 %s[42]: link down on eth0", udpApp)
+	udpConn.Close()
+
+	// <165> = local4.notice
+	text := "large " + strings.Repeat("x", 8000) + " end"
+	msg := fmt.Sprintf("<165>1 2026-10-11T22:14:15.003Z sw-core-2 %s - ID47 [origin@32473 region=\"east\"] %s", tcpApp, text)
+	tcpConn, err := net.Dial("tcp", address)
+	if err != nil {
+		t.Fatalf("Failed to dial syslog tcp: %v", err)
+	}
+	fmt.Fprintf(tcpConn, "%d %s", len(msg), msg)
+	tcpConn.Close()
+	time.Sleep(2 * time.Second)
+
+	udpEvents := getEventsBySource(t, client, udpApp)
+	if len(udpEvents) != 1 {
+		t.Fatalf("Expected 1 event for the udp message, got=%d", len(udpEvents))
+	}
+	event, _ := udpEvents[0].(map[string]interface{})
+	if eventType, _ := event["eventType"].(float64); eventType != 2 {
+		t.Fatalf("Expected eventType=2 (SYSLOG), got=%v", event["eventType"])
+	}
+	if event["category"] != "auth" || event["nodeName"] != "sw-core-1" || event["message"] != "link down on eth0" {
+		t.Fatalf("Expected category=auth nodeName=sw-core-1 message=link down on eth0, got category=%v nodeName=%v message=%v",
+			event["category"], event["nodeName"], event["message"])
+	}
+	if severity, _ := event["severity"].(float64); severity != 4 {
+		t.Fatalf("Expected severity=4 (MAJOR) for crit, got=%v", event["severity"])
+	}
+	if v := eventAttribute(event, "procId"); v != "42" {
+		t.Fatalf("Expected procId attribute 42, got=%q", v)
+	}
+
+	tcpEvents := getEventsBySource(t, client, tcpApp)
+	if len(tcpEvents) != 1 {
+		t.Fatalf("Expected 1 event for the tcp message, got=%d", len(tcpEvents))
+	}
+	event, _ = tcpEvents[0].(map[string]interface{})
+	if event["category"] != "local4" || event["nodeName"] != "sw-core-2" {
+		t.Fatalf("Expected category=local4 nodeName=sw-core-2, got category=%v nodeName=%v", event["category"], event["nodeName"])
+	}
+	if severity, _ := event["severity"].(float64); severity != 1 {
+		t.Fatalf("Expected severity=1 (INFO) for notice, got=%v", event["severity"])
+	}
+	if message, _ := event["message"].(string); message != text {
+		t.Fatalf("Expected the full %d byte message, got %d bytes", len(text), len(message))
+	}
+	if v := eventAttribute(event, "origin@32473.region"); v != "east" {
+		t.Fatalf("Expected structured data attribute region=east, got=%q", v)
+	}
+
+	// A second connection beyond MaxConnections is refused, the idle one closed
+	idleConn, err := net.Dial("tcp", address)
+	if err != nil {
+		t.Fatalf("Failed to dial syslog tcp: %v", err)
+	}
+	defer idleConn.Close()
+	time.Sleep(200 * time.Millisecond)
+	surplusConn, err := net.Dial("tcp", address)
+	if err != nil {
+		t.Fatalf("Failed to dial syslog tcp: %v", err)
+	}
+	defer surplusConn.Close()
+	buf := make([]byte, 1)
+	surplusConn.SetReadDeadline(time.Now().Add(500 * time.Millisecond))
+	if _, err := surplusConn.Read(buf); err != io.EOF {
+		t.Fatalf("Expected the connection beyond MaxConnections to be closed, got: %v", err)
+	}
+	idleConn.SetReadDeadline(time.Now().Add(3 * time.Second))
+	if _, err := idleConn.Read(buf); err != io.EOF {
+		t.Fatalf("Expected the idle connection to be closed, got: %v", err)
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where SourceIdentifier=%s", udpApp)))
+	client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where SourceIdentifier=%s", tcpApp)))
+}
+
+// testAlertmanagerWebhook verifies that Alertmanager notifications become
+// events keyed by fingerprint: a repeated firing delivery dedups into one
+// alarm, and the resolved delivery clears it.
+func testAlertmanagerWebhook(t *testing.T, client *mocks.Client) {
+	defId := ifs.NewUuid()
+	def := map[string]interface{}{
+		"definition_id":       defId,
+		"name":                "Alertmanager Webhook Test",
+		"status":              2, // ACTIVE
+		"default_severity":    4, // MAJOR
+		"event_pattern":       `^\[FIRING\] AlmTestHighCpu`,
+		"event_type_filter":   3, // THRESHOLD
+		"auto_clear_enabled":  true,
+		"auto_clear_seconds":  3600,
+		"clear_event_pattern": `^\[RESOLVED\] AlmTestHighCpu`,
+		"dedup_enabled":       true,
+	}
+	if _, err := client.Post("/alm/10/AlmDef", def); err != nil {
+		t.Fatalf("POST alertmanager test definition failed: %v", err)
+	}
+
+	fingerprint := ifs.NewUuid()[:16]
+	notification := func(status, endsAt string) map[string]interface{} {
+		return map[string]interface{}{
+			"version":     "4",
+			"groupKey":    "{}:{alertname=\"AlmTestHighCpu\"}",
+			"status":      status,
+			"receiver":    "alm",
+			"externalURL": "http://alertmanager:9093",
+			"commonLabels": map[string]string{
+				"alertname": "AlmTestHighCpu",
+			},
+			"alerts": []map[string]interface{}{{
+				"status": status,
+				"labels": map[string]string{
+					"alertname": "AlmTestHighCpu",
+					"instance":  "10.9.9.9:9100",
+					"severity":  "critical",
+					"job":       "node",
+				},
+				"annotations":  map[string]string{"summary": "CPU above 90%"},
+				"startsAt":     "2026-10-18T10:00:00Z",
+				"endsAt":       endsAt,
+				"generatorURL": "http://prometheus:9090/graph",
+				"fingerprint":  fingerprint,
+			}},
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Post("/alm/10/AlertMgr", notification("firing", "0001-01-01T00:00:00Z"))
+		if err != nil {
+			t.Fatalf("POST firing alertmanager notification failed: %v", err)
+		}
+		var result map[string]interface{}
+		if err := json.Unmarshal([]byte(resp), &result); err != nil {
+			t.Fatalf("Failed to parse webhook response: %v", err)
+		}
+		if ids, _ := result["eventIds"].([]interface{}); len(ids) != 1 {
+			t.Fatalf("Expected 1 event id in webhook response, got=%v", result["eventIds"])
+		}
+	}
+
+	firing := getEventsBySource(t, client, fingerprint)
+	if len(firing) != 2 {
+		t.Fatalf("Expected 2 events for the repeated firing alert, got=%d", len(firing))
+	}
+	event, _ := firing[0].(map[string]interface{})
+	if event["message"] != "[FIRING] AlmTestHighCpu: CPU above 90%" {
+		t.Fatalf("Unexpected firing event message: %v", event["message"])
+	}
+	if event["nodeName"] != "10.9.9.9" {
+		t.Fatalf("Expected nodeName from the instance label, got=%v", event["nodeName"])
+	}
+	if severity, _ := event["severity"].(float64); severity != 5 {
+		t.Fatalf("Expected severity=5 (CRITICAL), got=%v", event["severity"])
+	}
+	if v := eventAttribute(event, "job"); v != "node" {
+		t.Fatalf("Expected label attribute job=node, got=%q", v)
+	}
+
+	alarmResp, err := client.Get("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where DefinitionId=%s", defId)))
+	if err != nil {
+		t.Fatalf("GET alertmanager alarm failed: %v", err)
+	}
+	alarm, err := extractFirstFromList(alarmResp)
+	if err != nil {
+		t.Fatalf("Expected an alarm for the firing alert: %v", err)
+	}
+	if count, _ := alarm["occurrenceCount"].(float64); count != 2 {
+		t.Fatalf("Expected the repeated delivery to dedup into occurrenceCount=2, got=%v", alarm["occurrenceCount"])
+	}
+
+	if _, err := client.Post("/alm/10/AlertMgr", notification("resolved", "2026-10-18T10:05:00Z")); err != nil {
+		t.Fatalf("POST resolved alertmanager notification failed: %v", err)
+	}
+	alarmResp, err = client.Get("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarm["alarmId"])))
+	if err != nil {
+		t.Fatalf("GET resolved alarm failed: %v", err)
+	}
+	alarm, err = extractFirstFromList(alarmResp)
+	if err != nil {
+		t.Fatalf("Failed to parse resolved alarm: %v", err)
+	}
+	if state, _ := alarm["state"].(float64); state != 3 {
+		t.Fatalf("Expected the resolved notification to clear the alarm, got state=%v", alarm["state"])
+	}
+
+	// An alert without a node label falls back to the receiver as its node
+	// rather than failing the delivery.
+	nodeless := ifs.NewUuid()[:16]
+	if _, err := client.Post("/alm/10/AlertMgr", map[string]interface{}{
+		"version":  "4",
+		"status":   "firing",
+		"receiver": "alm",
+		"alerts": []map[string]interface{}{{
+			"status":      "firing",
+			"labels":      map[string]string{"alertname": "AlmTestNoNode"},
+			"startsAt":    "2026-10-18T10:00:00Z",
+			"fingerprint": nodeless,
+		}},
+	}); err != nil {
+		t.Fatalf("POST alertmanager notification without a node label failed: %v", err)
+	}
+	events := getEventsBySource(t, client, nodeless)
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event for the alert without a node label, got=%d", len(events))
+	}
+	event, _ = events[0].(map[string]interface{})
+	if event["nodeName"] != "alm" || event["nodeId"] == nil || event["nodeId"] == "" {
+		t.Fatalf("Expected the receiver as node, got nodeName=%v nodeId=%v", event["nodeName"], event["nodeId"])
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where DefinitionId=%s", defId)))
+	client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where SourceIdentifier=%s", fingerprint)))
+	client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where SourceIdentifier=%s", nodeless)))
+	client.Delete("/alm/10/AlmDef", mocks.L8QueryText(fmt.Sprintf("select * from AlarmDefinition where DefinitionId=%s", defId)))
+}
+
+// testGenericWebhook verifies that a JSON payload is mapped to events with
+// the JSONPath expressions of a webhook mapping, and that mappings with an
+// invalid path are rejected.
+func testGenericWebhook(t *testing.T, client *mocks.Client) {
+	invalid := map[string]interface{}{
+		"mapping_id":   ifs.NewUuid(),
+		"name":         "Invalid Webhook Mapping",
+		"status":       1, // ACTIVE
+		"message_path": "$.title[",
+	}
+	if _, err := client.Post("/alm/10/WebhkMap", invalid); err == nil {
+		t.Fatal("Expected a webhook mapping with an invalid path to be rejected")
+	}
+
+	mappingId := ifs.NewUuid()
+	mapping := map[string]interface{}{
+		"mapping_id":       mappingId,
+		"name":             "Test Incidents",
+		"status":           1, // ACTIVE
+		"events_path":      "$.incidents[*]",
+		"node_path":        "$.host",
+		"message_path":     "$.title",
+		"severity_path":    "$.priority",
+		"source_path":      "$.id",
+		"status_path":      "$.state",
+		"occurred_at_path": "$.openedAt",
+		"resolved_values":  []string{"closed"},
+		"default_severity": 2, // WARNING
+		"category":         "incidents",
+		"attribute_paths":  map[string]string{"team": "$.owner['team']"},
+	}
+	if _, err := client.Post("/alm/10/WebhkMap", mapping); err != nil {
+		t.Fatalf("POST webhook mapping failed: %v", err)
+	}
+
+	openId := "inc-open-" + ifs.NewUuid()[:8]
+	closedId := "inc-closed-" + ifs.NewUuid()[:8]
+	hostlessId := "inc-hostless-" + ifs.NewUuid()[:8]
+	payload := fmt.Sprintf(`{"incidents": [
+		{"id": "%s", "host": "db-7", "title": "Disk full", "priority": "high", "state": "open", "openedAt": 1791000000000, "owner": {"team": "dba"}},
+		{"id": "%s", "host": "db-8", "title": "Replica lag", "priority": "p9", "state": "CLOSED", "openedAt": "2026-10-18T10:00:00Z"},
+		{"id": "%s", "title": "Backup missed", "state": "open"}
+	]}`, openId, closedId, hostlessId)
+	// The payload is the source's JSON document as is, not an escaped string
+	resp, err := client.Post("/alm/10/Webhook", map[string]interface{}{"mapping_id": mappingId, "payload": json.RawMessage(payload)})
+	if err != nil {
+		t.Fatalf("POST generic webhook failed: %v", err)
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(resp), &result); err != nil {
+		t.Fatalf("Failed to parse webhook response: %v", err)
+	}
+	if ids, _ := result["eventIds"].([]interface{}); len(ids) != 3 {
+		t.Fatalf("Expected 3 event ids in webhook response, got=%v", result["eventIds"])
+	}
+
+	opened := getEventsBySource(t, client, openId)
+	if len(opened) != 1 {
+		t.Fatalf("Expected 1 event for the open incident, got=%d", len(opened))
+	}
+	event, _ := opened[0].(map[string]interface{})
+	if event["message"] != "[FIRING] Disk full" || event["nodeName"] != "db-7" || event["category"] != "incidents" {
+		t.Fatalf("Unexpected open incident event: message=%v nodeName=%v category=%v", event["message"], event["nodeName"], event["category"])
+	}
+	if severity, _ := event["severity"].(float64); severity != 4 {
+		t.Fatalf("Expected severity=4 (MAJOR) for priority high, got=%v", event["severity"])
+	}
+	if eventType, _ := event["eventType"].(float64); eventType != 7 {
+		t.Fatalf("Expected eventType=7 (CUSTOM), got=%v", event["eventType"])
+	}
+	if event["occurredAt"] != "1791000000" {
+		t.Fatalf("Expected occurredAt from unix milliseconds, got=%v", event["occurredAt"])
+	}
+	if v := eventAttribute(event, "team"); v != "dba" {
+		t.Fatalf("Expected attribute team=dba, got=%q", v)
+	}
+
+	closed := getEventsBySource(t, client, closedId)
+	if len(closed) != 1 {
+		t.Fatalf("Expected 1 event for the closed incident, got=%d", len(closed))
+	}
+	event, _ = closed[0].(map[string]interface{})
+	if event["message"] != "[RESOLVED] Replica lag" {
+		t.Fatalf("Expected the closed incident to be resolved, got message=%v", event["message"])
+	}
+	if severity, _ := event["severity"].(float64); severity != 2 {
+		t.Fatalf("Expected the default severity=2 (WARNING), got=%v", event["severity"])
+	}
+
+	hostless := getEventsBySource(t, client, hostlessId)
+	if len(hostless) != 1 {
+		t.Fatalf("Expected 1 event for the incident without a host, got=%d", len(hostless))
+	}
+	event, _ = hostless[0].(map[string]interface{})
+	if event["nodeName"] != common.WEBHOOK_DEFAULT_NODE {
+		t.Fatalf("Expected nodeName=%s for the incident without a host, got=%v", common.WEBHOOK_DEFAULT_NODE, event["nodeName"])
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where SourceIdentifier=%s", openId)))
+	client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where SourceIdentifier=%s", closedId)))
+	client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where SourceIdentifier=%s", hostlessId)))
+	client.Delete("/alm/10/WebhkMap", mocks.L8QueryText(fmt.Sprintf("select * from WebhookMapping where MappingId=%s", mappingId)))
+}
+
+// postHeartbeat posts a HEARTBEAT event of the node and source that occurred at a time.
+func postHeartbeat(t *testing.T, client *mocks.Client, nodeId, sourceIdentifier string, occurredAt int64) {
+	postProcessingEvent(t, client, nodeId, "heartbeat", map[string]interface{}{
+		"event_type":        5, // HEARTBEAT
+		"source_identifier": sourceIdentifier,
+		"occurred_at":       occurredAt,
+	})
+}
+
+// getHeartbeatLostAlarm returns the heartbeat lost alarm of a node, or nil if there is none.
+func getHeartbeatLostAlarm(t *testing.T, client *mocks.Client, nodeId string) map[string]interface{} {
+	resp, err := client.Get("/alm/10/Alarm", mocks.L8QueryText(
+		fmt.Sprintf("select * from Alarm where DefinitionId=%s and NodeId=%s", common.HEARTBEAT_DEFINITION_ID, nodeId)))
+	if err != nil {
+		t.Fatalf("GET heartbeat lost alarm failed: %v", err)
+	}
+	alarm, err := extractFirstFromList(resp)
+	if err != nil {
+		return nil
+	}
+	return alarm
+}
+
+// testHeartbeatMonitoring verifies that missed heartbeats raise a heartbeat
+// lost alarm after the missed intervals of a configured or learned interval,
+// and that the next heartbeat clears it, also after the tracker is rebuilt.
+func testHeartbeatMonitoring(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	tracker := events.HeartbeatTracker()
+	suffix := ifs.NewUuid()[:8]
+	now := time.Now().Unix()
+
+	// Configured interval: 60s, lost after 2 missed intervals
+	configuredNode := "hb-configured-" + suffix
+	monitorId := ifs.NewUuid()
+	monitor := map[string]interface{}{
+		"monitor_id":        monitorId,
+		"name":              "Test Agent Heartbeat",
+		"status":            1, // ACTIVE
+		"node_id":           configuredNode,
+		"source_identifier": "agent",
+		"interval_seconds":  60,
+		"missed_intervals":  2,
+		"severity":          5, // CRITICAL
+	}
+	if _, err := client.Post("/alm/10/HbMonitor", monitor); err != nil {
+		t.Fatalf("POST heartbeat monitor failed: %v", err)
+	}
+	postHeartbeat(t, client, configuredNode, "agent", now-10)
+
+	tracker.Sweep(now+100, vnic)
+	if alarm := getHeartbeatLostAlarm(t, client, configuredNode); alarm != nil {
+		t.Fatal("Expected no heartbeat lost alarm within 2 intervals")
+	}
+	tracker.Sweep(now+120, vnic)
+	alarm := getHeartbeatLostAlarm(t, client, configuredNode)
+	if alarm == nil {
+		t.Fatal("Expected a heartbeat lost alarm after 2 missed intervals")
+	}
+	if state, _ := alarm["state"].(float64); state != 1 {
+		t.Fatalf("Expected heartbeat lost alarm state=1 (ACTIVE), got=%v", alarm["state"])
+	}
+	if severity, _ := alarm["severity"].(float64); severity != 5 {
+		t.Fatalf("Expected the monitor severity=5 (CRITICAL), got=%v", alarm["severity"])
+	}
+
+	// A restart rebuilds the tracker from events and open alarms, so the
+	// resumed heartbeat still clears the alarm
+	if err := tracker.Rebuild(vnic); err != nil {
+		t.Fatalf("Failed to rebuild heartbeat tracker: %v", err)
+	}
+	postHeartbeat(t, client, configuredNode, "agent", now)
+	alarm = getHeartbeatLostAlarm(t, client, configuredNode)
+	if state, _ := alarm["state"].(float64); state != 3 {
+		t.Fatalf("Expected the resumed heartbeat to clear the alarm, got state=%v", alarm["state"])
+	}
+
+	// Learned interval: 100s from the gaps, lost after the default 3 intervals
+	learnedNode := "hb-learned-" + suffix
+	for _, ago := range []int64{400, 300, 200, 100} {
+		postHeartbeat(t, client, learnedNode, "agent", now-ago)
+	}
+	tracker.Sweep(now+150, vnic)
+	if alarm := getHeartbeatLostAlarm(t, client, learnedNode); alarm != nil {
+		t.Fatal("Expected no heartbeat lost alarm within 3 learned intervals")
+	}
+	tracker.Sweep(now+200, vnic)
+	alarm = getHeartbeatLostAlarm(t, client, learnedNode)
+	if alarm == nil {
+		t.Fatal("Expected a heartbeat lost alarm after 3 missed learned intervals")
+	}
+	if v, _ := alarm["attributes"].(map[string]interface{}); v["intervalSeconds"] != "100" {
+		t.Fatalf("Expected the learned interval of 100 seconds, got=%v", alarm["attributes"])
+	}
+	postHeartbeat(t, client, learnedNode, "agent", now+1)
+
+	// Heartbeat events past the retention are pruned, recent ones are kept
+	prunedNode := "hb-pruned-" + suffix
+	postHeartbeat(t, client, prunedNode, "old-"+suffix, now-common.HEARTBEAT_RETENTION_SECONDS-3600)
+	postHeartbeat(t, client, prunedNode, "new-"+suffix, now)
+	if err := events.PruneHeartbeats(now, vnic); err != nil {
+		t.Fatalf("Failed to prune heartbeat events: %v", err)
+	}
+	if old := getEventsBySource(t, client, "old-"+suffix); len(old) != 0 {
+		t.Fatalf("Expected the heartbeat event past the retention to be pruned, got=%d", len(old))
+	}
+	if recent := getEventsBySource(t, client, "new-"+suffix); len(recent) != 1 {
+		t.Fatalf("Expected the recent heartbeat event to be kept, got=%d", len(recent))
+	}
+
+	// Cleanup
+	for _, node := range []string{configuredNode, learnedNode, prunedNode} {
+		client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where NodeId=%s", node)))
+		client.Delete("/alm/10/Event", mocks.L8QueryText(fmt.Sprintf("select * from Event where NodeId=%s", node)))
+	}
+	client.Delete("/alm/10/HbMonitor", mocks.L8QueryText(fmt.Sprintf("select * from HeartbeatMonitor where MonitorId=%s", monitorId)))
+}
+
+// testEventNormalization verifies that normalization rules parse events
+// before they are persisted: captures become attributes and fill the
+// category, subcategory and node name templates, rules run in priority
+// order, stop ends the run, and the event type filter is honored.
+func testEventNormalization(t *testing.T, client *mocks.Client) {
+	invalid := map[string]interface{}{
+		"rule_id": ifs.NewUuid(),
+		"name":    "Invalid Pattern",
+		"status":  1, // ACTIVE
+		"pattern": "%{NOSUCHPATTERN:x}",
+	}
+	if _, err := client.Post("/alm/10/NormRule", invalid); err == nil {
+		t.Fatal("Expected a rule with an unknown grok pattern to be rejected")
+	}
+
+	rules := []map[string]interface{}{
+		{
+			// Runs first but only for TRAP events
+			"rule_id":           ifs.NewUuid(),
+			"name":              "Trap Only",
+			"status":            1, // ACTIVE
+			"priority":          5,
+			"event_type_filter": 1, // TRAP
+			"pattern":           `^NORMTEST`,
+			"category":          "trap-only",
+		},
+		{
+			"rule_id":           ifs.NewUuid(),
+			"name":              "Interface Change",
+			"status":            1, // ACTIVE
+			"priority":          10,
+			"event_type_filter": 6, // CONFIGURATION
+			"pattern":           `^NORMTEST %{IPORHOST:device} %{INTERFACE:interface} changed to %{WORD:state}$`,
+			"category":          "interface",
+			"subcategory":       "${interface}-${state}",
+			"node_name":         "${device}",
+			"severity":          3, // MINOR
+		},
+		{
+			"rule_id":           ifs.NewUuid(),
+			"name":              "Link State",
+			"status":            1, // ACTIVE
+			"priority":          20,
+			"event_type_filter": 6, // CONFIGURATION
+			"pattern":           `changed to (?P<state>down|up)$`,
+			"category":          "link",
+			"stop":              true,
+		},
+		{
+			// Never reached: the link state rule stops the run
+			"rule_id":  ifs.NewUuid(),
+			"name":     "After Stop",
+			"status":   1, // ACTIVE
+			"priority": 30,
+			"pattern":  `^NORMTEST`,
+			"category": "after-stop",
+		},
+		{
+			// The syslog time must not be taken for an IPv6 address
+			"rule_id":  ifs.NewUuid(),
+			"name":     "Login Source",
+			"status":   1, // ACTIVE
+			"priority": 40,
+			"pattern":  `^IPTEST .*?%{IP:addr}`,
+		},
+	}
+	for _, rule := range rules {
+		if _, err := client.Post("/alm/10/NormRule", rule); err != nil {
+			t.Fatalf("POST normalization rule %v failed: %v", rule["name"], err)
+		}
+	}
+	defer func() {
+		for _, rule := range rules {
+			client.Delete("/alm/10/NormRule", mocks.L8QueryText(fmt.Sprintf("select * from NormalizationRule where RuleId=%s", rule["rule_id"])))
+		}
+	}()
+
+	patch := map[string]interface{}{"rule_id": rules[1]["rule_id"], "pattern": "%{NOSUCHPATTERN:x}"}
+	if _, err := client.Patch("/alm/10/NormRule", patch); err == nil {
+		t.Fatal("Expected a PATCH to an unknown grok pattern to be rejected")
+	}
+
+	event := postProcessingEvent(t, client, "norm-test-node", "NORMTEST sw1.example.com Gi0/1 changed to down", map[string]interface{}{
+		"event_type": 6, // CONFIGURATION
+		"node_name":  "unparsed",
+	})
+	if event["category"] != "link" {
+		t.Fatalf("Expected the stop rule to set category=link, got=%v", event["category"])
+	}
+	if event["subcategory"] != "Gi0/1-down" {
+		t.Fatalf("Expected subcategory=Gi0/1-down, got=%v", event["subcategory"])
+	}
+	if event["nodeName"] != "sw1.example.com" {
+		t.Fatalf("Expected nodeName to be rewritten to sw1.example.com, got=%v", event["nodeName"])
+	}
+	if severity, _ := event["severity"].(float64); severity != 3 {
+		t.Fatalf("Expected severity=3 (MINOR), got=%v", event["severity"])
+	}
+	if eventAttribute(event, "device") != "sw1.example.com" || eventAttribute(event, "interface") != "Gi0/1" ||
+		eventAttribute(event, "state") != "down" {
+		t.Fatalf("Expected device, interface and state attributes, got=%v", event["attributes"])
+	}
+
+	for message, addr := range map[string]string{
+		"IPTEST Oct 18 10:00:00 sshd: login from 10.1.2.3":    "10.1.2.3",
+		"IPTEST Oct 18 10:00:00 sshd: login from 2001:db8::1": "2001:db8::1",
+	} {
+		event = postProcessingEvent(t, client, "norm-test-node", message, map[string]interface{}{
+			"event_type": 2, // SYSLOG
+		})
+		if v := eventAttribute(event, "addr"); v != addr {
+			t.Fatalf("Expected addr=%s from %q, got=%q", addr, message, v)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"fmt"
+	"github.com/saichler/l8alarms/go/alm/alarms"
+	"github.com/saichler/l8alarms/go/alm/maintenancewindows"
+	"github.com/saichler/l8alarms/go/tests/mocks"
+	"github.com/saichler/l8types/go/ifs"
+	"testing"
+	"time"
+)
+
+func testMaintenance(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	testRecurringMaintenanceWindow(t, client)
+	testMaintenanceLifecycle(t, client, vnic)
+	testMaintenanceWindowPatch(t, client)
+}
+
+// postMaintenanceWindow creates a window scoped to a single node and returns its ID.
+func postMaintenanceWindow(t *testing.T, client *mocks.Client, nodeId string, status, recurrence int, start, end int64) string {
+	windowId := ifs.NewUuid()
+	window := map[string]interface{}{
+		"window_id":       windowId,
+		"name":            "Maintenance Test " + nodeId,
+		"status":          status,
+		"start_time":      start,
+		"end_time":        end,
+		"recurrence":      recurrence,
+		"node_ids":        []string{nodeId},
+		"suppress_alarms": true,
+	}
+	if _, err := client.Post("/alm/10/MaintWin", window); err != nil {
+		t.Fatalf("POST maintenance window failed: %v", err)
+	}
+	return windowId
+}
+
+// testRecurringMaintenanceWindow verifies that a daily window whose first
+// occurrence was yesterday suppresses alarms during today's occurrence, while
+// the same non-recurring window has expired.
+func testRecurringMaintenanceWindow(t *testing.T, client *mocks.Client) {
+	start := time.Now().Unix() - 86400 - 600
+	end := start + 3600
+
+	// Daily recurrence (value 2), status ACTIVE (value 2)
+	recurringId := postMaintenanceWindow(t, client, "node-recur-test-01", 2, 2, start, end)
+	// No recurrence (value 1)
+	onceId := postMaintenanceWindow(t, client, "node-recur-test-02", 2, 1, start, end)
+
+	recurringAlarm := postTestAlarm(t, client, "node-recur-test-01", 1, nil)
+	onceAlarm := postTestAlarm(t, client, "node-recur-test-02", 1, nil)
+	time.Sleep(1 * time.Second)
+	if state := getAlarmState(t, client, recurringAlarm); state != 4 {
+		t.Fatalf("Expected alarm in today's daily occurrence to be SUPPRESSED (4), got=%v", state)
+	}
+	if state := getAlarmState(t, client, onceAlarm); state != 1 {
+		t.Fatalf("Expected alarm after an expired one-time window to stay ACTIVE (1), got=%v", state)
+	}
+
+	// Cleanup
+	for _, id := range []string{recurringAlarm, onceAlarm} {
+		client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", id)))
+	}
+	for _, id := range []string{recurringId, onceId} {
+		client.Delete("/alm/10/MaintWin", mocks.L8QueryText(fmt.Sprintf("select * from MaintenanceWindow where WindowId=%s", id)))
+	}
+}
+
+// getMaintenanceWindowStatus reads back the window's status.
+func getMaintenanceWindowStatus(t *testing.T, client *mocks.Client, windowId string) int {
+	q := mocks.L8QueryText(fmt.Sprintf("select * from MaintenanceWindow where WindowId=%s", windowId))
+	getResp, err := client.Get("/alm/10/MaintWin", q)
+	if err != nil {
+		t.Fatalf("GET maintenance window failed: %v", err)
+	}
+	result, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse maintenance window response: %v", err)
+	}
+	status, _ := result["status"].(float64)
+	return int(status)
+}
+
+// testMaintenanceLifecycle verifies that the scheduler activates a SCHEDULED
+// window at its start time, completes it at its end time, and releases the
+// alarms it suppressed back to ACTIVE.
+func testMaintenanceLifecycle(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	now := time.Now().Unix()
+
+	// SCHEDULED (value 1) window that has already started
+	scheduledId := postMaintenanceWindow(t, client, "node-lifecycle-01", 1, 1, now-60, now+3600)
+	// ACTIVE (value 2) window that ends in a few seconds
+	endingId := postMaintenanceWindow(t, client, "node-lifecycle-02", 2, 1, now-60, now+3)
+
+	alarmId := postTestAlarm(t, client, "node-lifecycle-02", 1, nil)
+	time.Sleep(1 * time.Second)
+	if state := getAlarmState(t, client, alarmId); state != 4 {
+		t.Fatalf("Expected alarm in ending window to be SUPPRESSED (4), got=%v", state)
+	}
+
+	time.Sleep(4 * time.Second)
+	maintenancewindows.RunLifecycle(vnic, alarms.ReleaseSuppressed)
+	time.Sleep(1 * time.Second)
+
+	if status := getMaintenanceWindowStatus(t, client, scheduledId); status != 2 {
+		t.Fatalf("Expected started window to become ACTIVE (2), got=%d", status)
+	}
+	if status := getMaintenanceWindowStatus(t, client, endingId); status != 3 {
+		t.Fatalf("Expected ended window to become COMPLETED (3), got=%d", status)
+	}
+
+	q := mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId))
+	getResp, err := client.Get("/alm/10/Alarm", q)
+	if err != nil {
+		t.Fatalf("GET released alarm failed: %v", err)
+	}
+	alarm, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse released alarm response: %v", err)
+	}
+	if s, _ := alarm["state"].(float64); int(s) != 1 {
+		t.Fatalf("Expected released alarm state=1 (ACTIVE), got=%v", s)
+	}
+	if suppressed, _ := alarm["isSuppressed"].(bool); suppressed {
+		t.Fatal("Expected released alarm isSuppressed=false")
+	}
+
+	// Cleanup
+	client.Delete("/alm/10/Alarm", mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId)))
+	for _, id := range []string{scheduledId, endingId} {
+		client.Delete("/alm/10/MaintWin", mocks.L8QueryText(fmt.Sprintf("select * from MaintenanceWindow where WindowId=%s", id)))
+	}
+}
+
+// testMaintenanceWindowPatch verifies that a PATCH is validated against the
+// stored window: moving the end before the stored start or setting a negative
+// recurrence interval is rejected, while a valid new end is accepted.
+func testMaintenanceWindowPatch(t *testing.T, client *mocks.Client) {
+	start := time.Now().Unix() + 86400
+	// SCHEDULED (value 1), no recurrence (value 1)
+	windowId := postMaintenanceWindow(t, client, "node-patch-test-01", 1, 1, start, start+3600)
+
+	if _, err := client.Patch("/alm/10/MaintWin", map[string]interface{}{
+		"window_id": windowId,
+		"end_time":  start - 60,
+	}); err == nil {
+		t.Fatal("Expected PATCH moving end_time before the stored start_time to be rejected")
+	}
+	if _, err := client.Patch("/alm/10/MaintWin", map[string]interface{}{
+		"window_id":           windowId,
+		"recurrence_interval": -1,
+	}); err == nil {
+		t.Fatal("Expected PATCH with a negative recurrence_interval to be rejected")
+	}
+	if _, err := client.Patch("/alm/10/MaintWin", map[string]interface{}{
+		"window_id": windowId,
+		"end_time":  start + 7200,
+	}); err != nil {
+		t.Fatalf("Expected PATCH with a valid end_time to be accepted: %v", err)
+	}
+
+	client.Delete("/alm/10/MaintWin", mocks.L8QueryText(fmt.Sprintf("select * from MaintenanceWindow where WindowId=%s", windowId)))
+}
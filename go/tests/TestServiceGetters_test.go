@@ -4,11 +4,17 @@ import (
 	"github.com/saichler/l8alarms/go/alm/alarmdefinitions"
 	"github.com/saichler/l8alarms/go/alm/alarmfilters"
 	"github.com/saichler/l8alarms/go/alm/alarms"
+	"github.com/saichler/l8alarms/go/alm/archivepolicies"
 	"github.com/saichler/l8alarms/go/alm/correlationrules"
 	"github.com/saichler/l8alarms/go/alm/escalationpolicies"
+	"github.com/saichler/l8alarms/go/alm/escalationstates"
 	"github.com/saichler/l8alarms/go/alm/events"
+	"github.com/saichler/l8alarms/go/alm/heartbeatmonitors"
 	"github.com/saichler/l8alarms/go/alm/maintenancewindows"
+	"github.com/saichler/l8alarms/go/alm/normalizationrules"
 	"github.com/saichler/l8alarms/go/alm/notificationpolicies"
+	"github.com/saichler/l8alarms/go/alm/trapmappings"
+	"github.com/saichler/l8alarms/go/alm/webhookmappings"
 	"github.com/saichler/l8types/go/ifs"
 	"testing"
 )
@@ -34,10 +40,28 @@ func testServiceGetters(t *testing.T, vnic ifs.IVNic) {
 	if _, err := escalationpolicies.EscalationPolicy("test-id", vnic); err != nil {
 		log.Fail(t, "EscalationPolicy getter failed: ", err.Error())
 	}
+	if _, err := escalationstates.EscalationState("test-id", vnic); err != nil {
+		log.Fail(t, "EscalationState getter failed: ", err.Error())
+	}
 	if _, err := maintenancewindows.MaintenanceWindow("test-id", vnic); err != nil {
 		log.Fail(t, "MaintenanceWindow getter failed: ", err.Error())
 	}
 	if _, err := alarmfilters.GetAlarmFilter("test-id", vnic); err != nil {
 		log.Fail(t, "AlarmFilter getter failed: ", err.Error())
 	}
+	if _, err := archivepolicies.ArchiveRetentionPolicy("test-id", vnic); err != nil {
+		log.Fail(t, "ArchiveRetentionPolicy getter failed: ", err.Error())
+	}
+	if _, err := trapmappings.TrapMapping("test-id", vnic); err != nil {
+		log.Fail(t, "TrapMapping getter failed: ", err.Error())
+	}
+	if _, err := webhookmappings.WebhookMapping("test-id", vnic); err != nil {
+		log.Fail(t, "WebhookMapping getter failed: ", err.Error())
+	}
+	if _, err := heartbeatmonitors.HeartbeatMonitor("test-id", vnic); err != nil {
+		log.Fail(t, "HeartbeatMonitor getter failed: ", err.Error())
+	}
+	if _, err := normalizationrules.NormalizationRule("test-id", vnic); err != nil {
+		log.Fail(t, "NormalizationRule getter failed: ", err.Error())
+	}
 }
@@ -4,11 +4,17 @@ import (
 	"github.com/saichler/l8alarms/go/alm/alarmdefinitions"
 	"github.com/saichler/l8alarms/go/alm/alarmfilters"
 	"github.com/saichler/l8alarms/go/alm/alarms"
+	"github.com/saichler/l8alarms/go/alm/archivepolicies"
 	"github.com/saichler/l8alarms/go/alm/correlationrules"
 	"github.com/saichler/l8alarms/go/alm/escalationpolicies"
+	"github.com/saichler/l8alarms/go/alm/escalationstates"
 	"github.com/saichler/l8alarms/go/alm/events"
+	"github.com/saichler/l8alarms/go/alm/heartbeatmonitors"
 	"github.com/saichler/l8alarms/go/alm/maintenancewindows"
+	"github.com/saichler/l8alarms/go/alm/normalizationrules"
 	"github.com/saichler/l8alarms/go/alm/notificationpolicies"
+	"github.com/saichler/l8alarms/go/alm/trapmappings"
+	"github.com/saichler/l8alarms/go/alm/webhookmappings"
 	"github.com/saichler/l8types/go/ifs"
 	"testing"
 )
@@ -34,10 +40,28 @@ func testServiceHandlers(t *testing.T, vnic ifs.IVNic) {
 	if h, ok := escalationpolicies.EscalationPolicies(vnic); !ok || h == nil {
 		log.Fail(t, "EscalationPolicy service handler not found")
 	}
+	if h, ok := escalationstates.EscalationStates(vnic); !ok || h == nil {
+		log.Fail(t, "EscalationState service handler not found")
+	}
 	if h, ok := maintenancewindows.MaintenanceWindows(vnic); !ok || h == nil {
 		log.Fail(t, "MaintenanceWindow service handler not found")
 	}
 	if h, ok := alarmfilters.AlarmFilters(vnic); !ok || h == nil {
 		log.Fail(t, "AlarmFilter service handler not found")
 	}
+	if h, ok := archivepolicies.ArchiveRetentionPolicies(vnic); !ok || h == nil {
+		log.Fail(t, "ArchiveRetentionPolicy service handler not found")
+	}
+	if h, ok := trapmappings.TrapMappings(vnic); !ok || h == nil {
+		log.Fail(t, "TrapMapping service handler not found")
+	}
+	if h, ok := webhookmappings.WebhookMappings(vnic); !ok || h == nil {
+		log.Fail(t, "WebhookMapping service handler not found")
+	}
+	if h, ok := heartbeatmonitors.HeartbeatMonitors(vnic); !ok || h == nil {
+		log.Fail(t, "HeartbeatMonitor service handler not found")
+	}
+	if h, ok := normalizationrules.NormalizationRules(vnic); !ok || h == nil {
+		log.Fail(t, "NormalizationRule service handler not found")
+	}
 }
@@ -2,17 +2,22 @@ package tests
 
 import (
 	"fmt"
+	"github.com/saichler/l8alarms/go/alm/alarms"
+	"github.com/saichler/l8alarms/go/alm/archiving"
 	"github.com/saichler/l8alarms/go/tests/mocks"
+	"github.com/saichler/l8alarms/go/types/alm"
 	"github.com/saichler/l8types/go/ifs"
+	"google.golang.org/protobuf/proto"
 	"strings"
 	"testing"
 )
 
-func testValidation(t *testing.T, client *mocks.Client) {
+func testValidation(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
 	testValidationAlarmDefinition(t, client)
 	testValidationAlarm(t, client)
 	testValidationEvent(t, client)
 	testValidationCorrelationRule(t, client)
+	testValidationCorrelationConditions(t, client)
 	testValidationNotificationPolicy(t, client)
 	testValidationEscalationPolicy(t, client)
 	testValidationMaintenanceWindow(t, client)
@@ -20,6 +25,9 @@ func testValidation(t *testing.T, client *mocks.Client) {
 	testValidationAutoID(t, client)
 	testValidationEventImmutability(t, client)
 	testValidationAlarmFieldProtection(t, client)
+	testValidationAlarmEngineFields(t, client)
+	testValidationInternalWrites(t, client, vnic)
+	testValidationAlarmStateHistory(t, client)
 }
 
 func testValidationAlarmDefinition(t *testing.T, client *mocks.Client) {
@@ -98,6 +106,97 @@ func testValidationCorrelationRule(t *testing.T, client *mocks.Client) {
 	}
 }
 
+func testValidationCorrelationConditions(t *testing.T, client *mocks.Client) {
+	// Malformed conditions — each should be rejected on save, naming the bad operator or value
+	malformed := []struct {
+		cond map[string]interface{}
+		want string
+	}{
+		{map[string]interface{}{"field": "name", "operator": 4, "value": "(["}, `invalid regex "(["`},                                    // REGEX that does not compile
+		{map[string]interface{}{"field": "severity", "operator": 5, "value": "urgent"}, `requires a severity or a number, got "urgent"`}, // GREATER_THAN without a severity
+		{map[string]interface{}{"field": "nodeId", "operator": 7, "value": " , "}, "IN requires a comma-separated list"},                 // IN without values
+		{map[string]interface{}{"field": "", "operator": 1, "value": "x"}, "Field is required"},                                          // missing field
+		{map[string]interface{}{"field": "name", "operator": 0, "value": "x"}, "invalid operator 0"},                                     // UNSPECIFIED operator
+		{map[string]interface{}{"field": "bogusField", "operator": 1, "value": "x"}, `unknown alarm field "bogusField"`},                 // unknown alarm field
+		{map[string]interface{}{"field": "attributes", "operator": 1, "value": "x"}, `unknown alarm field "attributes"`},                 // map without a key
+	}
+	for i, m := range malformed {
+		rule := map[string]interface{}{
+			"rule_id":    ifs.NewUuid(),
+			"name":       fmt.Sprintf("Malformed Condition Rule %d", i),
+			"rule_type":  1,
+			"status":     1, // DRAFT
+			"conditions": []map[string]interface{}{m.cond},
+		}
+		_, err := client.Post("/alm/10/CorrRule", rule)
+		if err == nil {
+			t.Fatalf("POST CorrelationRule with malformed condition %v should have failed", m.cond)
+		}
+		if !strings.Contains(err.Error(), m.want) {
+			t.Fatalf("Expected %q error for condition %v, got: %v", m.want, m.cond, err)
+		}
+	}
+
+	// Well-formed conditions using every operator — should succeed
+	ruleId := ifs.NewUuid()
+	rule := map[string]interface{}{
+		"rule_id":   ruleId,
+		"name":      "All Operators Rule",
+		"rule_type": 1,
+		"status":    1, // DRAFT
+		"conditions": []map[string]interface{}{
+			{"field": "name", "operator": 1, "value": "linkDown"},
+			{"field": "name", "operator": 2, "value": "linkUp"},
+			{"field": "name", "operator": 3, "value": "link"},
+			{"field": "name", "operator": 4, "value": "^link.*"},
+			{"field": "severity", "operator": 5, "value": "MINOR"},
+			{"field": "severity", "operator": 6, "value": "SEVERITY_CRITICAL"},
+			{"field": "nodeId", "operator": 7, "value": "node-a, node-b"},
+			{"field": "linkId", "operator": 2, "value": ""},
+			{"field": "source_identifier", "operator": 3, "value": "Gi0"},
+			{"field": "occurrenceCount", "operator": 5, "value": "3"},
+			{"field": "originalSeverity", "operator": 7, "value": "SEVERITY_MAJOR,SEVERITY_CRITICAL"},
+			{"field": "attributes.ifIndex", "operator": 1, "value": "7"},
+		},
+	}
+	if _, err := client.Post("/alm/10/CorrRule", rule); err != nil {
+		t.Fatalf("POST CorrelationRule with well-formed conditions failed: %v", err)
+	}
+
+	// A PATCH is validated like a POST
+	_, err := client.Patch("/alm/10/CorrRule", map[string]interface{}{
+		"rule_id":    ruleId,
+		"conditions": []map[string]interface{}{{"field": "name", "operator": 4, "value": "(["}},
+	})
+	if err == nil {
+		t.Fatal("PATCH CorrelationRule with malformed condition should have failed")
+	}
+	if !strings.Contains(err.Error(), `invalid regex "(["`) {
+		t.Fatalf("Expected invalid regex error on PATCH, got: %v", err)
+	}
+
+	// Policies share the same conditions — a malformed one is rejected
+	for _, endpoint := range []string{"/alm/10/NotifPol", "/alm/10/EscPolicy"} {
+		policy := map[string]interface{}{
+			"policy_id":  ifs.NewUuid(),
+			"name":       "Malformed Condition Policy",
+			"status":     1,
+			"conditions": []map[string]interface{}{{"field": "bogusField", "operator": 1, "value": "x"}},
+		}
+		_, err := client.Post(endpoint, policy)
+		if err == nil {
+			t.Fatalf("POST %s with unknown condition field should have failed", endpoint)
+		}
+		if !strings.Contains(err.Error(), `unknown alarm field "bogusField"`) {
+			t.Fatalf("Expected unknown alarm field error from %s, got: %v", endpoint, err)
+		}
+	}
+
+	// Cleanup
+	delQ := mocks.L8QueryText(fmt.Sprintf("select * from CorrelationRule where RuleId=%s", ruleId))
+	_, _ = client.Delete("/alm/10/CorrRule", delQ)
+}
+
 func testValidationNotificationPolicy(t *testing.T, client *mocks.Client) {
 	// Missing name — should fail
 	polNoName := map[string]interface{}{
@@ -238,9 +337,52 @@ func testValidationEventImmutability(t *testing.T, client *mocks.Client) {
 		t.Fatalf("Expected immutability error, got: %v", err)
 	}
 
-	// Cleanup
+	// PATCH should be rejected as well
+	_, err = client.Patch("/alm/10/Event", map[string]interface{}{
+		"event_id": eventId,
+		"message":  "Should Not Patch",
+	})
+	if err == nil {
+		t.Fatal("PATCH Event should have been rejected (events are immutable)")
+	}
+	if !strings.Contains(err.Error(), "immutable") {
+		t.Fatalf("Expected immutability error, got: %v", err)
+	}
+
+	// DELETE is reserved to the archiver
 	delQ := mocks.L8QueryText(fmt.Sprintf("select * from Event where EventId=%s", eventId))
-	_, _ = client.Delete("/alm/10/Event", delQ)
+	if _, err = client.Delete("/alm/10/Event", delQ); err == nil {
+		t.Fatal("DELETE Event should have been rejected (events are immutable)")
+	}
+	getResp, err := client.Get("/alm/10/Event", delQ)
+	if err != nil {
+		t.Fatalf("GET Event failed: %v", err)
+	}
+	if !strings.Contains(getResp, "Immutability Test Event") {
+		t.Fatalf("Expected the event to be unchanged, got: %s", getResp)
+	}
+
+	// Archived records are immutable too
+	archivedId := ifs.NewUuid()
+	archived := map[string]interface{}{
+		"alarm_id":      archivedId,
+		"definition_id": testStore.DefinitionIDs[0],
+		"node_id":       "test-node-001",
+		"name":          "Immutable Archive Test",
+	}
+	if _, err := client.Post("/alm/10/ArcAlarm", archived); err != nil {
+		t.Fatalf("POST ArchivedAlarm failed: %v", err)
+	}
+	if _, err := client.Patch("/alm/10/ArcAlarm", map[string]interface{}{
+		"alarm_id": archivedId,
+		"name":     "Should Not Patch",
+	}); err == nil {
+		t.Fatal("PATCH ArchivedAlarm should have been rejected (archives are immutable)")
+	}
+	arcQ := mocks.L8QueryText(fmt.Sprintf("select * from ArchivedAlarm where AlarmId=%s", archivedId))
+	if _, err := client.Delete("/alm/10/ArcAlarm", arcQ); err == nil {
+		t.Fatal("DELETE ArchivedAlarm should have been rejected (archives are immutable)")
+	}
 }
 
 func testValidationAlarmFieldProtection(t *testing.T, client *mocks.Client) {
@@ -277,7 +419,239 @@ func testValidationAlarmFieldProtection(t *testing.T, client *mocks.Client) {
 		t.Fatalf("PUT Alarm with only user-editable field change should succeed: %v", err)
 	}
 
+	// PATCH is held to the same rules
+	_, err = client.Patch("/alm/10/Alarm", map[string]interface{}{
+		"alarm_id": alarmId,
+		"node_id":  "test-node-002",
+	})
+	if err == nil {
+		t.Fatal("PATCH Alarm with changed system field should have been rejected")
+	}
+	if !strings.Contains(err.Error(), "system-managed") {
+		t.Fatalf("Expected system-managed field error, got: %v", err)
+	}
+	_, err = client.Patch("/alm/10/Alarm", map[string]interface{}{
+		"alarm_id": alarmId,
+		"state":    3, // CLEARED
+	})
+	if err != nil {
+		t.Fatalf("PATCH Alarm state ACKNOWLEDGED -> CLEARED should succeed: %v", err)
+	}
+	_, err = client.Patch("/alm/10/Alarm", map[string]interface{}{
+		"alarm_id": alarmId,
+		"state":    4, // SUPPRESSED
+	})
+	if err == nil {
+		t.Fatal("PATCH Alarm state CLEARED -> SUPPRESSED should have been rejected")
+	}
+	if !strings.Contains(err.Error(), "invalid state transition") {
+		t.Fatalf("Expected invalid state transition error, got: %v", err)
+	}
+
 	// Cleanup
 	delQ := mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId))
 	_, _ = client.Delete("/alm/10/Alarm", delQ)
 }
+
+// testValidationAlarmEngineFields verifies that operators cannot change the
+// fields written by the correlation and maintenance engines, and that a PUT
+// omitting them keeps the stored values.
+func testValidationAlarmEngineFields(t *testing.T, client *mocks.Client) {
+	alarmId := ifs.NewUuid()
+	alarm := map[string]interface{}{
+		"alarm_id":      alarmId,
+		"definition_id": testStore.DefinitionIDs[0],
+		"node_id":       "test-node-engine-fields",
+		"state":         3, // CLEARED, so correlation leaves it alone
+		"severity":      1,
+		"name":          "Engine Fields Test",
+		"is_root_cause": true,
+		"symptom_count": 2,
+	}
+	if _, err := client.Post("/alm/10/Alarm", alarm); err != nil {
+		t.Fatalf("POST Alarm for engine fields test failed: %v", err)
+	}
+	delQ := mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId))
+	defer client.Delete("/alm/10/Alarm", delQ)
+
+	for field, value := range map[string]interface{}{
+		"root_cause_alarm_id": "some-other-alarm",
+		"symptom_count":       5,
+		"is_suppressed":       true,
+		"suppressed_by":       "operator",
+	} {
+		_, err := client.Patch("/alm/10/Alarm", map[string]interface{}{
+			"alarm_id": alarmId,
+			field:      value,
+		})
+		if err == nil {
+			t.Fatalf("PATCH Alarm %s should have been rejected", field)
+		}
+		if !strings.Contains(err.Error(), "managed by the alarm engines") {
+			t.Fatalf("Expected engine field error for %s, got: %v", field, err)
+		}
+	}
+
+	// A full PUT without the engine fields keeps them
+	delete(alarm, "is_root_cause")
+	delete(alarm, "symptom_count")
+	alarm["severity"] = 2
+	if _, err := client.Put("/alm/10/Alarm", alarm); err != nil {
+		t.Fatalf("PUT Alarm without engine fields should succeed: %v", err)
+	}
+	getResp, err := client.Get("/alm/10/Alarm", delQ)
+	if err != nil {
+		t.Fatalf("GET Alarm failed: %v", err)
+	}
+	result, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse alarm response: %v", err)
+	}
+	if isRootCause, _ := result["isRootCause"].(bool); !isRootCause {
+		t.Fatal("Expected isRootCause to survive an operator PUT")
+	}
+	if count, _ := result["symptomCount"].(float64); count != 2 {
+		t.Fatalf("Expected symptomCount=2 to survive an operator PUT, got=%v", result["symptomCount"])
+	}
+}
+
+// testValidationInternalWrites verifies that the internal mark survives the
+// real service handler: an engine PUT through alarms.PutInternal may write
+// the engine fields an operator PATCH cannot, and the archiver may delete an
+// archived alarm an operator DELETE cannot.
+func testValidationInternalWrites(t *testing.T, client *mocks.Client, vnic ifs.IVNic) {
+	alarmId := ifs.NewUuid()
+	if _, err := client.Post("/alm/10/Alarm", map[string]interface{}{
+		"alarm_id":      alarmId,
+		"definition_id": testStore.DefinitionIDs[0],
+		"node_id":       "test-node-internal-writes",
+		"state":         3, // CLEARED, so correlation leaves it alone
+		"severity":      1,
+		"name":          "Internal Writes Test",
+	}); err != nil {
+		t.Fatalf("POST Alarm for internal writes test failed: %v", err)
+	}
+	delQ := mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId))
+	defer client.Delete("/alm/10/Alarm", delQ)
+
+	if _, err := client.Patch("/alm/10/Alarm", map[string]interface{}{
+		"alarm_id":            alarmId,
+		"root_cause_alarm_id": "internal-root",
+	}); err == nil || !strings.Contains(err.Error(), "managed by the alarm engines") {
+		t.Fatalf("Expected operator PATCH of rootCauseAlarmId to be rejected, got: %v", err)
+	}
+
+	stored, err := alarms.GetAlarm(alarmId, vnic)
+	if err != nil || stored == nil {
+		t.Fatalf("GetAlarm failed: %v", err)
+	}
+	// A copy, so the stored alarm is compared with a distinct element
+	update := proto.Clone(stored).(*alm.Alarm)
+	update.RootCauseAlarmId = "internal-root"
+	update.SymptomCount = 3
+	if err := alarms.PutInternal(update, vnic); err != nil {
+		t.Fatalf("PutInternal of engine fields should succeed: %v", err)
+	}
+	getResp, err := client.Get("/alm/10/Alarm", delQ)
+	if err != nil {
+		t.Fatalf("GET Alarm failed: %v", err)
+	}
+	result, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse alarm response: %v", err)
+	}
+	if result["rootCauseAlarmId"] != "internal-root" {
+		t.Fatalf("Expected rootCauseAlarmId written by PutInternal, got=%v", result["rootCauseAlarmId"])
+	}
+	if count, _ := result["symptomCount"].(float64); count != 3 {
+		t.Fatalf("Expected symptomCount=3 written by PutInternal, got=%v", result["symptomCount"])
+	}
+
+	archivedId := ifs.NewUuid()
+	if _, err := client.Post("/alm/10/ArcAlarm", map[string]interface{}{
+		"alarm_id":      archivedId,
+		"definition_id": testStore.DefinitionIDs[0],
+		"node_id":       "test-node-internal-writes",
+		"name":          "Internal Delete Test",
+	}); err != nil {
+		t.Fatalf("POST ArchivedAlarm failed: %v", err)
+	}
+	arcQ := fmt.Sprintf("select * from ArchivedAlarm where AlarmId=%s", archivedId)
+	if _, err := client.Delete("/alm/10/ArcAlarm", mocks.L8QueryText(arcQ)); err == nil {
+		t.Fatal("Expected operator DELETE of an archived alarm to be rejected")
+	}
+	if err := archiving.DeleteArchivedAlarm(archivedId, vnic); err != nil {
+		t.Fatalf("DeleteArchivedAlarm should succeed: %v", err)
+	}
+	if n := countEntities(t, client, "/alm/10/ArcAlarm", arcQ); n != 0 {
+		t.Fatalf("Expected the archived alarm to be deleted by the archiver, got=%d", n)
+	}
+}
+
+// testValidationAlarmStateHistory verifies that operator state changes are
+// recorded in state_history with who made them, and that operators cannot
+// rewrite the history or the transition stamps.
+func testValidationAlarmStateHistory(t *testing.T, client *mocks.Client) {
+	alarmId := ifs.NewUuid()
+	alarm := map[string]interface{}{
+		"alarm_id":      alarmId,
+		"definition_id": testStore.DefinitionIDs[0],
+		"node_id":       "test-node-history",
+		"state":         1, // ACTIVE
+		"severity":      1,
+		"name":          "State History Test",
+	}
+	if _, err := client.Post("/alm/10/Alarm", alarm); err != nil {
+		t.Fatalf("POST Alarm for state history test failed: %v", err)
+	}
+	delQ := mocks.L8QueryText(fmt.Sprintf("select * from Alarm where AlarmId=%s", alarmId))
+	defer client.Delete("/alm/10/Alarm", delQ)
+
+	alarm["state"] = 2 // ACKNOWLEDGED
+	alarm["acknowledged_by"] = "alice"
+	alarm["acknowledged_at"] = 1
+	alarm["state_history"] = []map[string]interface{}{
+		{"from_state": 3, "to_state": 1, "changed_by": "forged"},
+	}
+	if _, err := client.Put("/alm/10/Alarm", alarm); err != nil {
+		t.Fatalf("PUT Alarm acknowledge failed: %v", err)
+	}
+	if _, err := client.Patch("/alm/10/Alarm", map[string]interface{}{
+		"alarm_id": alarmId,
+		"state":    3, // CLEARED
+	}); err != nil {
+		t.Fatalf("PATCH Alarm clear failed: %v", err)
+	}
+
+	getResp, err := client.Get("/alm/10/Alarm", delQ)
+	if err != nil {
+		t.Fatalf("GET Alarm failed: %v", err)
+	}
+	result, err := extractFirstFromList(getResp)
+	if err != nil {
+		t.Fatalf("Failed to parse alarm response: %v", err)
+	}
+	if result["acknowledgedBy"] != "alice" {
+		t.Fatalf("Expected acknowledgedBy=alice, got=%v", result["acknowledgedBy"])
+	}
+	if at, _ := result["acknowledgedAt"].(string); at == "" || at == "1" {
+		t.Fatalf("Expected acknowledgedAt to be stamped by the transition, got=%v", result["acknowledgedAt"])
+	}
+	history, _ := result["stateHistory"].([]interface{})
+	if len(history) != 2 {
+		t.Fatalf("Expected 2 state history entries (forged entry dropped), got=%d", len(history))
+	}
+	for i, expected := range []struct {
+		to float64
+		by string
+	}{{2, "alice"}, {3, "operator"}} {
+		entry, _ := history[i].(map[string]interface{})
+		if to, _ := entry["toState"].(float64); to != expected.to || entry["changedBy"] != expected.by ||
+			entry["reason"] != "[operator]" {
+			t.Fatalf("Unexpected state history entry %d: %v", i, entry)
+		}
+		if at, _ := entry["changedAt"].(string); at == "" {
+			t.Fatalf("Expected state history entry %d to be timestamped", i)
+		}
+	}
+}
@@ -142,6 +142,35 @@ func (c *Client) Put(endpoint string, data interface{}) (string, error) {
 	return string(respBody), nil
 }
 
+func (c *Client) Patch(endpoint string, data interface{}) (string, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	req, err := http.NewRequest("PATCH", c.baseURL+endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return string(respBody), fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return string(respBody), nil
+}
+
 func (c *Client) Delete(endpoint string, queryJSON string) (string, error) {
 	req, err := http.NewRequest("DELETE", c.baseURL+endpoint, bytes.NewReader([]byte(queryJSON)))
 	if err != nil {
@@ -19,6 +19,7 @@ func generateAlarms(store *MockDataStore) []*alm.Alarm {
 
 		firstOccurrence := randomPastDate(0, 7)
 		lastOccurrence := firstOccurrence + int64(rand.Intn(3600))
+		sourceIdentifier := fmt.Sprintf("%s:SNMP", nodeIDs[nodeIdx])
 
 		a := &alm.Alarm{
 			AlarmId:          genID("alm", i),
@@ -28,11 +29,11 @@ func generateAlarms(store *MockDataStore) []*alm.Alarm {
 			NodeId:           nodeIDs[nodeIdx],
 			NodeName:         nodeNames[nodeIdx],
 			Location:         locations[nodeIdx],
-			SourceIdentifier: fmt.Sprintf("%s:SNMP", nodeIDs[nodeIdx]),
+			SourceIdentifier: sourceIdentifier,
 			FirstOccurrence:  firstOccurrence,
 			LastOccurrence:   lastOccurrence,
 			OccurrenceCount:  int32(rand.Intn(50) + 1),
-			DedupKey:         fmt.Sprintf("%s:%s", nodeIDs[nodeIdx], pickRef(store.DefinitionIDs, defIdx)),
+			DedupKey:         fmt.Sprintf("%s:%s", nodeIDs[nodeIdx], sourceIdentifier),
 			EventId:          pickRef(store.EventIDs, i),
 			Attributes: map[string]string{
 				"nodeType": nodeTypes[nodeIdx],
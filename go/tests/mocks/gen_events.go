@@ -60,6 +60,9 @@ func generateEvents(store *MockDataStore) []*alm.Event {
 		// Assign some events to alarms (will be populated in Phase 4)
 		if i < 5 {
 			event.ProcessingState = l8events.EventState_EVENT_STATE_NEW
+			// Pending events are raised per interface, so alarms the processing
+			// engine raises for them do not dedup into the Phase 4 mock alarms.
+			event.SourceIdentifier = fmt.Sprintf("%s:ifIndex.%d", nodeIDs[nodeIdx], i+1)
 		} else if i > 35 {
 			event.ProcessingState = l8events.EventState_EVENT_STATE_DISCARDED
 		}
@@ -22,6 +22,107 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ArchiveRecordType int32
+
+const (
+	ArchiveRecordType_ARCHIVE_RECORD_TYPE_UNSPECIFIED ArchiveRecordType = 0
+	ArchiveRecordType_ARCHIVE_RECORD_TYPE_ALARM       ArchiveRecordType = 1
+	ArchiveRecordType_ARCHIVE_RECORD_TYPE_EVENT       ArchiveRecordType = 2
+)
+
+// Enum value maps for ArchiveRecordType.
+var (
+	ArchiveRecordType_name = map[int32]string{
+		0: "ARCHIVE_RECORD_TYPE_UNSPECIFIED",
+		1: "ARCHIVE_RECORD_TYPE_ALARM",
+		2: "ARCHIVE_RECORD_TYPE_EVENT",
+	}
+	ArchiveRecordType_value = map[string]int32{
+		"ARCHIVE_RECORD_TYPE_UNSPECIFIED": 0,
+		"ARCHIVE_RECORD_TYPE_ALARM":       1,
+		"ARCHIVE_RECORD_TYPE_EVENT":       2,
+	}
+)
+
+func (x ArchiveRecordType) Enum() *ArchiveRecordType {
+	p := new(ArchiveRecordType)
+	*p = x
+	return p
+}
+
+func (x ArchiveRecordType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveRecordType) Descriptor() protoreflect.EnumDescriptor {
+	return file_alm_archive_proto_enumTypes[0].Descriptor()
+}
+
+func (ArchiveRecordType) Type() protoreflect.EnumType {
+	return &file_alm_archive_proto_enumTypes[0]
+}
+
+func (x ArchiveRecordType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveRecordType.Descriptor instead.
+func (ArchiveRecordType) EnumDescriptor() ([]byte, []int) {
+	return file_alm_archive_proto_rawDescGZIP(), []int{0}
+}
+
+type ArchiveExportFormat int32
+
+const (
+	ArchiveExportFormat_ARCHIVE_EXPORT_FORMAT_UNSPECIFIED ArchiveExportFormat = 0
+	ArchiveExportFormat_ARCHIVE_EXPORT_FORMAT_CSV         ArchiveExportFormat = 1
+	ArchiveExportFormat_ARCHIVE_EXPORT_FORMAT_JSONL       ArchiveExportFormat = 2
+	ArchiveExportFormat_ARCHIVE_EXPORT_FORMAT_PARQUET     ArchiveExportFormat = 3
+)
+
+// Enum value maps for ArchiveExportFormat.
+var (
+	ArchiveExportFormat_name = map[int32]string{
+		0: "ARCHIVE_EXPORT_FORMAT_UNSPECIFIED",
+		1: "ARCHIVE_EXPORT_FORMAT_CSV",
+		2: "ARCHIVE_EXPORT_FORMAT_JSONL",
+		3: "ARCHIVE_EXPORT_FORMAT_PARQUET",
+	}
+	ArchiveExportFormat_value = map[string]int32{
+		"ARCHIVE_EXPORT_FORMAT_UNSPECIFIED": 0,
+		"ARCHIVE_EXPORT_FORMAT_CSV":         1,
+		"ARCHIVE_EXPORT_FORMAT_JSONL":       2,
+		"ARCHIVE_EXPORT_FORMAT_PARQUET":     3,
+	}
+)
+
+func (x ArchiveExportFormat) Enum() *ArchiveExportFormat {
+	p := new(ArchiveExportFormat)
+	*p = x
+	return p
+}
+
+func (x ArchiveExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_alm_archive_proto_enumTypes[1].Descriptor()
+}
+
+func (ArchiveExportFormat) Type() protoreflect.EnumType {
+	return &file_alm_archive_proto_enumTypes[1]
+}
+
+func (x ArchiveExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveExportFormat.Descriptor instead.
+func (ArchiveExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_alm_archive_proto_rawDescGZIP(), []int{1}
+}
+
 // ArchivedAlarm: Immutable copy of a cleared/resolved alarm.
 type ArchivedAlarm struct {
 	state         protoimpl.MessageState
@@ -69,6 +170,8 @@ type ArchivedAlarm struct {
 	// Archive metadata
 	ArchivedAt int64  `protobuf:"varint,31,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
 	ArchivedBy string `protobuf:"bytes,32,opt,name=archived_by,json=archivedBy,proto3" json:"archived_by,omitempty"`
+	AssignedTo string `protobuf:"bytes,33,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	AssignedAt int64  `protobuf:"varint,34,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
 }
 
 func (x *ArchivedAlarm) Reset() {
@@ -327,6 +430,20 @@ func (x *ArchivedAlarm) GetArchivedBy() string {
 	return ""
 }
 
+func (x *ArchivedAlarm) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *ArchivedAlarm) GetAssignedAt() int64 {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return 0
+}
+
 // ArchivedEvent: Immutable copy of a processed event.
 type ArchivedEvent struct {
 	state         protoimpl.MessageState
@@ -636,6 +753,563 @@ func (x *ArchivedEventList) GetMetadata() *l8api.L8MetaData {
 	return nil
 }
 
+// ArchiveRequest: Archives an alarm, or every alarm matching a query, together
+// with its events and symptoms, or restores an archived alarm tree. Posted to
+// the AlmArchive service.
+type ArchiveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AlarmId string `protobuf:"bytes,1,opt,name=alarm_id,json=alarmId,proto3" json:"alarm_id,omitempty"`
+	// Alarm query, e.g. "select * from Alarm where State=3"
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Recorded as archived_by, or as changed_by of the restore state_history entry
+	ArchivedBy string `protobuf:"bytes,3,opt,name=archived_by,json=archivedBy,proto3" json:"archived_by,omitempty"`
+	// Undo an interrupted archive of alarm_id instead of archiving it
+	Rollback bool `protobuf:"varint,4,opt,name=rollback,proto3" json:"rollback,omitempty"`
+	// Restore the archived alarm_id, its symptoms and events to the active tables
+	Restore bool `protobuf:"varint,5,opt,name=restore,proto3" json:"restore,omitempty"`
+}
+
+func (x *ArchiveRequest) Reset() {
+	*x = ArchiveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_alm_archive_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveRequest) ProtoMessage() {}
+
+func (x *ArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alm_archive_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_alm_archive_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ArchiveRequest) GetAlarmId() string {
+	if x != nil {
+		return x.AlarmId
+	}
+	return ""
+}
+
+func (x *ArchiveRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ArchiveRequest) GetArchivedBy() string {
+	if x != nil {
+		return x.ArchivedBy
+	}
+	return ""
+}
+
+func (x *ArchiveRequest) GetRollback() bool {
+	if x != nil {
+		return x.Rollback
+	}
+	return false
+}
+
+func (x *ArchiveRequest) GetRestore() bool {
+	if x != nil {
+		return x.Restore
+	}
+	return false
+}
+
+// ArchiveResponse: Result of an ArchiveRequest.
+type ArchiveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArchivedAlarmIds []string `protobuf:"bytes,1,rep,name=archived_alarm_ids,json=archivedAlarmIds,proto3" json:"archived_alarm_ids,omitempty"`
+	// Alarms that failed to archive, as "<alarm_id>: <error>"
+	Errors           []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	RestoredAlarmIds []string `protobuf:"bytes,3,rep,name=restored_alarm_ids,json=restoredAlarmIds,proto3" json:"restored_alarm_ids,omitempty"`
+}
+
+func (x *ArchiveResponse) Reset() {
+	*x = ArchiveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_alm_archive_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveResponse) ProtoMessage() {}
+
+func (x *ArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alm_archive_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_alm_archive_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ArchiveResponse) GetArchivedAlarmIds() []string {
+	if x != nil {
+		return x.ArchivedAlarmIds
+	}
+	return nil
+}
+
+func (x *ArchiveResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ArchiveResponse) GetRestoredAlarmIds() []string {
+	if x != nil {
+		return x.RestoredAlarmIds
+	}
+	return nil
+}
+
+// ArchiveRetentionPolicy: Purges archived alarms and/or events older than
+// max_age_days, optionally only those of the given severities and alarm
+// definitions. Enforced by the background archive purger.
+type ArchiveRetentionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PolicyId    string          `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Name        string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string          `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status      AlmPolicyStatus `protobuf:"varint,4,opt,name=status,proto3,enum=alm.AlmPolicyStatus" json:"status,omitempty"`
+	// What to purge
+	PurgeAlarms bool `protobuf:"varint,5,opt,name=purge_alarms,json=purgeAlarms,proto3" json:"purge_alarms,omitempty"`
+	PurgeEvents bool `protobuf:"varint,6,opt,name=purge_events,json=purgeEvents,proto3" json:"purge_events,omitempty"`
+	// Match criteria, by archived_at; empty lists match any value
+	MaxAgeDays         int32               `protobuf:"varint,7,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`
+	Severities         []l8events.Severity `protobuf:"varint,8,rep,packed,name=severities,proto3,enum=l8events.Severity" json:"severities,omitempty"`
+	AlarmDefinitionIds []string            `protobuf:"bytes,9,rep,name=alarm_definition_ids,json=alarmDefinitionIds,proto3" json:"alarm_definition_ids,omitempty"`
+	// Report what would be purged without deleting anything
+	DryRun bool `protobuf:"varint,10,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Write purged records to a gzip-compressed JSONL file under
+	// export_directory before deleting them
+	ExportBeforePurge bool `protobuf:"varint,11,opt,name=export_before_purge,json=exportBeforePurge,proto3" json:"export_before_purge,omitempty"`
+	// Subdirectory of the server's EXPORT_DIRECTORY, "" for EXPORT_DIRECTORY itself;
+	// may not contain path separators or ".."
+	ExportDirectory string `protobuf:"bytes,12,opt,name=export_directory,json=exportDirectory,proto3" json:"export_directory,omitempty"`
+	// Result of the last purger run
+	LastRunAt      int64  `protobuf:"varint,13,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastAlarmCount int32  `protobuf:"varint,14,opt,name=last_alarm_count,json=lastAlarmCount,proto3" json:"last_alarm_count,omitempty"`
+	LastEventCount int32  `protobuf:"varint,15,opt,name=last_event_count,json=lastEventCount,proto3" json:"last_event_count,omitempty"`
+	LastExportFile string `protobuf:"bytes,16,opt,name=last_export_file,json=lastExportFile,proto3" json:"last_export_file,omitempty"`
+	CreatedAt      int64  `protobuf:"varint,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64  `protobuf:"varint,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ArchiveRetentionPolicy) Reset() {
+	*x = ArchiveRetentionPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_alm_archive_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveRetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveRetentionPolicy) ProtoMessage() {}
+
+func (x *ArchiveRetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_alm_archive_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveRetentionPolicy.ProtoReflect.Descriptor instead.
+func (*ArchiveRetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_alm_archive_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ArchiveRetentionPolicy) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *ArchiveRetentionPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArchiveRetentionPolicy) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ArchiveRetentionPolicy) GetStatus() AlmPolicyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AlmPolicyStatus_ALM_POLICY_STATUS_UNSPECIFIED
+}
+
+func (x *ArchiveRetentionPolicy) GetPurgeAlarms() bool {
+	if x != nil {
+		return x.PurgeAlarms
+	}
+	return false
+}
+
+func (x *ArchiveRetentionPolicy) GetPurgeEvents() bool {
+	if x != nil {
+		return x.PurgeEvents
+	}
+	return false
+}
+
+func (x *ArchiveRetentionPolicy) GetMaxAgeDays() int32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+func (x *ArchiveRetentionPolicy) GetSeverities() []l8events.Severity {
+	if x != nil {
+		return x.Severities
+	}
+	return nil
+}
+
+func (x *ArchiveRetentionPolicy) GetAlarmDefinitionIds() []string {
+	if x != nil {
+		return x.AlarmDefinitionIds
+	}
+	return nil
+}
+
+func (x *ArchiveRetentionPolicy) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ArchiveRetentionPolicy) GetExportBeforePurge() bool {
+	if x != nil {
+		return x.ExportBeforePurge
+	}
+	return false
+}
+
+func (x *ArchiveRetentionPolicy) GetExportDirectory() string {
+	if x != nil {
+		return x.ExportDirectory
+	}
+	return ""
+}
+
+func (x *ArchiveRetentionPolicy) GetLastRunAt() int64 {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return 0
+}
+
+func (x *ArchiveRetentionPolicy) GetLastAlarmCount() int32 {
+	if x != nil {
+		return x.LastAlarmCount
+	}
+	return 0
+}
+
+func (x *ArchiveRetentionPolicy) GetLastEventCount() int32 {
+	if x != nil {
+		return x.LastEventCount
+	}
+	return 0
+}
+
+func (x *ArchiveRetentionPolicy) GetLastExportFile() string {
+	if x != nil {
+		return x.LastExportFile
+	}
+	return ""
+}
+
+func (x *ArchiveRetentionPolicy) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ArchiveRetentionPolicy) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ArchiveRetentionPolicyList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List     []*ArchiveRetentionPolicy `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Metadata *l8api.L8MetaData         `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *ArchiveRetentionPolicyList) Reset() {
+	*x = ArchiveRetentionPolicyList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_alm_archive_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveRetentionPolicyList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveRetentionPolicyList) ProtoMessage() {}
+
+func (x *ArchiveRetentionPolicyList) ProtoReflect() protoreflect.Message {
+	mi := &file_alm_archive_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveRetentionPolicyList.ProtoReflect.Descriptor instead.
+func (*ArchiveRetentionPolicyList) Descriptor() ([]byte, []int) {
+	return file_alm_archive_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ArchiveRetentionPolicyList) GetList() []*ArchiveRetentionPolicy {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ArchiveRetentionPolicyList) GetMetadata() *l8api.L8MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// ArchiveExportRequest: Exports archived alarms or events to a flat file.
+// Posted to the ArcExport service.
+type ArchiveExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordType ArchiveRecordType   `protobuf:"varint,1,opt,name=record_type,json=recordType,proto3,enum=alm.ArchiveRecordType" json:"record_type,omitempty"`
+	Format     ArchiveExportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=alm.ArchiveExportFormat" json:"format,omitempty"`
+	// Occurrence time range (first_occurrence of alarms, occurred_at of
+	// events), inclusive; 0 leaves the range open
+	FromTime     int64  `protobuf:"varint,3,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime       int64  `protobuf:"varint,4,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	NodeId       string `protobuf:"bytes,5,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	DefinitionId string `protobuf:"bytes,6,opt,name=definition_id,json=definitionId,proto3" json:"definition_id,omitempty"`
+	// Attribute keys exported as their own attr_<key> columns; all attributes
+	// are also exported together in the attributes column
+	AttributeKeys []string `protobuf:"bytes,7,rep,name=attribute_keys,json=attributeKeys,proto3" json:"attribute_keys,omitempty"`
+}
+
+func (x *ArchiveExportRequest) Reset() {
+	*x = ArchiveExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_alm_archive_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveExportRequest) ProtoMessage() {}
+
+func (x *ArchiveExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alm_archive_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveExportRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveExportRequest) Descriptor() ([]byte, []int) {
+	return file_alm_archive_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ArchiveExportRequest) GetRecordType() ArchiveRecordType {
+	if x != nil {
+		return x.RecordType
+	}
+	return ArchiveRecordType_ARCHIVE_RECORD_TYPE_UNSPECIFIED
+}
+
+func (x *ArchiveExportRequest) GetFormat() ArchiveExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ArchiveExportFormat_ARCHIVE_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ArchiveExportRequest) GetFromTime() int64 {
+	if x != nil {
+		return x.FromTime
+	}
+	return 0
+}
+
+func (x *ArchiveExportRequest) GetToTime() int64 {
+	if x != nil {
+		return x.ToTime
+	}
+	return 0
+}
+
+func (x *ArchiveExportRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ArchiveExportRequest) GetDefinitionId() string {
+	if x != nil {
+		return x.DefinitionId
+	}
+	return ""
+}
+
+func (x *ArchiveExportRequest) GetAttributeKeys() []string {
+	if x != nil {
+		return x.AttributeKeys
+	}
+	return nil
+}
+
+// ArchiveExportResponse: Result of an ArchiveExportRequest.
+type ArchiveExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilePath    string `protobuf:"bytes,1,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	RecordCount int32  `protobuf:"varint,2,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+}
+
+func (x *ArchiveExportResponse) Reset() {
+	*x = ArchiveExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_alm_archive_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveExportResponse) ProtoMessage() {}
+
+func (x *ArchiveExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alm_archive_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveExportResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveExportResponse) Descriptor() ([]byte, []int) {
+	return file_alm_archive_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArchiveExportResponse) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *ArchiveExportResponse) GetRecordCount() int32 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
 var File_alm_archive_proto protoreflect.FileDescriptor
 
 var file_alm_archive_proto_rawDesc = []byte{
@@ -644,7 +1318,7 @@ var file_alm_archive_proto_rawDesc = []byte{
 	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x61, 0x6c, 0x6d, 0x2d,
 	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x6c, 0x38,
 	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x09, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x0a, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x0a, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68,
 	0x69, 0x76, 0x65, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x61,
 	0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x61,
 	0x72, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
@@ -722,70 +1396,185 @@ var file_alm_archive_proto_rawDesc = []byte{
 	0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x1f, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f,
 	0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x20, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x1a,
-	0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbf,
-	0x05, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x11, 0x2e, 0x61, 0x6c, 0x6d, 0x2e, 0x41, 0x6c, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3f, 0x0a,
-	0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c, 0x38, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x70,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17,
-	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x38, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x53,
-	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72,
-	0x61, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
-	0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x12,
-	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x61, 0x6c, 0x6d, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f,
-	0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79,
-	0x22, 0x6a, 0x0a, 0x11, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x6c, 0x61, 0x72,
-	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x6c, 0x6d, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x6c, 0x38, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x38, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6a, 0x0a, 0x11,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x61, 0x6c, 0x6d, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x38,
-	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x38, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2f, 0x61, 0x6c, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x21,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x6f,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x22, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41,
+	0x74, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xbf, 0x05, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x61, 0x6c, 0x6d, 0x2e, 0x41, 0x6c, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x3f, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c, 0x38, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f,
+	0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x38, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x61, 0x6c, 0x6d, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x42, 0x79, 0x22, 0x6a, 0x0a, 0x11, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x6c,
+	0x61, 0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x6c, 0x6d, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x2d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x38, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x38, 0x4d, 0x65, 0x74, 0x61,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6a,
+	0x0a, 0x11, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x6c, 0x6d, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6c, 0x38, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x38, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41,
+	0x6c, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x61, 0x72,
+	0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x73, 0x22, 0xb7, 0x05,
+	0x0a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x6c,
+	0x6d, 0x2e, 0x41, 0x6c, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x5f, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x70, 0x75, 0x72, 0x67, 0x65, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x70, 0x75, 0x72, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x38, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x73, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x5f, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x5f, 0x70, 0x75, 0x72, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0b, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x6c, 0x61, 0x72, 0x6d,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7c, 0x0a, 0x1a, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x6c, 0x6d, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x38, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x38, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x02, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37,
+	0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x6c, 0x6d, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x6c, 0x6d, 0x2e, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x72,
+	0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x73, 0x22, 0x57, 0x0a, 0x15, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x76, 0x0a,
+	0x11, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45,
+	0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x52, 0x43, 0x48, 0x49,
+	0x56, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x4c, 0x41, 0x52, 0x4d, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56,
+	0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0x9f, 0x01, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x25, 0x0a,
+	0x21, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f,
+	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53,
+	0x56, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x45,
+	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f,
+	0x4e, 0x4c, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f,
+	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x41,
+	0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x03, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2f, 0x61, 0x6c, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -800,42 +1589,58 @@ func file_alm_archive_proto_rawDescGZIP() []byte {
 	return file_alm_archive_proto_rawDescData
 }
 
-var file_alm_archive_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_alm_archive_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_alm_archive_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_alm_archive_proto_goTypes = []interface{}{
-	(*ArchivedAlarm)(nil),             // 0: alm.ArchivedAlarm
-	(*ArchivedEvent)(nil),             // 1: alm.ArchivedEvent
-	(*ArchivedAlarmList)(nil),         // 2: alm.ArchivedAlarmList
-	(*ArchivedEventList)(nil),         // 3: alm.ArchivedEventList
-	nil,                               // 4: alm.ArchivedAlarm.AttributesEntry
-	(l8events.AlarmState)(0),          // 5: l8events.AlarmState
-	(l8events.Severity)(0),            // 6: l8events.Severity
-	(*l8events.AlarmNote)(nil),        // 7: l8events.AlarmNote
-	(*l8events.AlarmStateChange)(nil), // 8: l8events.AlarmStateChange
-	(AlmEventType)(0),                 // 9: alm.AlmEventType
-	(l8events.EventState)(0),          // 10: l8events.EventState
-	(*EventAttribute)(nil),            // 11: alm.EventAttribute
-	(*l8api.L8MetaData)(nil),          // 12: l8api.L8MetaData
+	(ArchiveRecordType)(0),             // 0: alm.ArchiveRecordType
+	(ArchiveExportFormat)(0),           // 1: alm.ArchiveExportFormat
+	(*ArchivedAlarm)(nil),              // 2: alm.ArchivedAlarm
+	(*ArchivedEvent)(nil),              // 3: alm.ArchivedEvent
+	(*ArchivedAlarmList)(nil),          // 4: alm.ArchivedAlarmList
+	(*ArchivedEventList)(nil),          // 5: alm.ArchivedEventList
+	(*ArchiveRequest)(nil),             // 6: alm.ArchiveRequest
+	(*ArchiveResponse)(nil),            // 7: alm.ArchiveResponse
+	(*ArchiveRetentionPolicy)(nil),     // 8: alm.ArchiveRetentionPolicy
+	(*ArchiveRetentionPolicyList)(nil), // 9: alm.ArchiveRetentionPolicyList
+	(*ArchiveExportRequest)(nil),       // 10: alm.ArchiveExportRequest
+	(*ArchiveExportResponse)(nil),      // 11: alm.ArchiveExportResponse
+	nil,                                // 12: alm.ArchivedAlarm.AttributesEntry
+	(l8events.AlarmState)(0),           // 13: l8events.AlarmState
+	(l8events.Severity)(0),             // 14: l8events.Severity
+	(*l8events.AlarmNote)(nil),         // 15: l8events.AlarmNote
+	(*l8events.AlarmStateChange)(nil),  // 16: l8events.AlarmStateChange
+	(AlmEventType)(0),                  // 17: alm.AlmEventType
+	(l8events.EventState)(0),           // 18: l8events.EventState
+	(*EventAttribute)(nil),             // 19: alm.EventAttribute
+	(*l8api.L8MetaData)(nil),           // 20: l8api.L8MetaData
+	(AlmPolicyStatus)(0),               // 21: alm.AlmPolicyStatus
 }
 var file_alm_archive_proto_depIdxs = []int32{
-	5,  // 0: alm.ArchivedAlarm.state:type_name -> l8events.AlarmState
-	6,  // 1: alm.ArchivedAlarm.severity:type_name -> l8events.Severity
-	6,  // 2: alm.ArchivedAlarm.original_severity:type_name -> l8events.Severity
-	4,  // 3: alm.ArchivedAlarm.attributes:type_name -> alm.ArchivedAlarm.AttributesEntry
-	7,  // 4: alm.ArchivedAlarm.notes:type_name -> l8events.AlarmNote
-	8,  // 5: alm.ArchivedAlarm.state_history:type_name -> l8events.AlarmStateChange
-	9,  // 6: alm.ArchivedEvent.event_type:type_name -> alm.AlmEventType
-	10, // 7: alm.ArchivedEvent.processing_state:type_name -> l8events.EventState
-	6,  // 8: alm.ArchivedEvent.severity:type_name -> l8events.Severity
-	11, // 9: alm.ArchivedEvent.attributes:type_name -> alm.EventAttribute
-	0,  // 10: alm.ArchivedAlarmList.list:type_name -> alm.ArchivedAlarm
-	12, // 11: alm.ArchivedAlarmList.metadata:type_name -> l8api.L8MetaData
-	1,  // 12: alm.ArchivedEventList.list:type_name -> alm.ArchivedEvent
-	12, // 13: alm.ArchivedEventList.metadata:type_name -> l8api.L8MetaData
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	13, // 0: alm.ArchivedAlarm.state:type_name -> l8events.AlarmState
+	14, // 1: alm.ArchivedAlarm.severity:type_name -> l8events.Severity
+	14, // 2: alm.ArchivedAlarm.original_severity:type_name -> l8events.Severity
+	12, // 3: alm.ArchivedAlarm.attributes:type_name -> alm.ArchivedAlarm.AttributesEntry
+	15, // 4: alm.ArchivedAlarm.notes:type_name -> l8events.AlarmNote
+	16, // 5: alm.ArchivedAlarm.state_history:type_name -> l8events.AlarmStateChange
+	17, // 6: alm.ArchivedEvent.event_type:type_name -> alm.AlmEventType
+	18, // 7: alm.ArchivedEvent.processing_state:type_name -> l8events.EventState
+	14, // 8: alm.ArchivedEvent.severity:type_name -> l8events.Severity
+	19, // 9: alm.ArchivedEvent.attributes:type_name -> alm.EventAttribute
+	2,  // 10: alm.ArchivedAlarmList.list:type_name -> alm.ArchivedAlarm
+	20, // 11: alm.ArchivedAlarmList.metadata:type_name -> l8api.L8MetaData
+	3,  // 12: alm.ArchivedEventList.list:type_name -> alm.ArchivedEvent
+	20, // 13: alm.ArchivedEventList.metadata:type_name -> l8api.L8MetaData
+	21, // 14: alm.ArchiveRetentionPolicy.status:type_name -> alm.AlmPolicyStatus
+	14, // 15: alm.ArchiveRetentionPolicy.severities:type_name -> l8events.Severity
+	8,  // 16: alm.ArchiveRetentionPolicyList.list:type_name -> alm.ArchiveRetentionPolicy
+	20, // 17: alm.ArchiveRetentionPolicyList.metadata:type_name -> l8api.L8MetaData
+	0,  // 18: alm.ArchiveExportRequest.record_type:type_name -> alm.ArchiveRecordType
+	1,  // 19: alm.ArchiveExportRequest.format:type_name -> alm.ArchiveExportFormat
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_alm_archive_proto_init() }
@@ -894,19 +1699,92 @@ func file_alm_archive_proto_init() {
 				return nil
 			}
 		}
+		file_alm_archive_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_alm_archive_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_alm_archive_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRetentionPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_alm_archive_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRetentionPolicyList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_alm_archive_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_alm_archive_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_alm_archive_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_alm_archive_proto_goTypes,
 		DependencyIndexes: file_alm_archive_proto_depIdxs,
+		EnumInfos:         file_alm_archive_proto_enumTypes,
 		MessageInfos:      file_alm_archive_proto_msgTypes,
 	}.Build()
 	File_alm_archive_proto = out.File
@@ -415,6 +415,115 @@ func (ConditionOperator) EnumDescriptor() ([]byte, []int) {
 	return file_alm_common_proto_rawDescGZIP(), []int{6}
 }
 
+// Event field a normalization rule parses
+type NormalizationField int32
+
+const (
+	NormalizationField_NORMALIZATION_FIELD_UNSPECIFIED NormalizationField = 0
+	NormalizationField_NORMALIZATION_FIELD_MESSAGE     NormalizationField = 1
+	NormalizationField_NORMALIZATION_FIELD_RAW_DATA    NormalizationField = 2
+)
+
+// Enum value maps for NormalizationField.
+var (
+	NormalizationField_name = map[int32]string{
+		0: "NORMALIZATION_FIELD_UNSPECIFIED",
+		1: "NORMALIZATION_FIELD_MESSAGE",
+		2: "NORMALIZATION_FIELD_RAW_DATA",
+	}
+	NormalizationField_value = map[string]int32{
+		"NORMALIZATION_FIELD_UNSPECIFIED": 0,
+		"NORMALIZATION_FIELD_MESSAGE":     1,
+		"NORMALIZATION_FIELD_RAW_DATA":    2,
+	}
+)
+
+func (x NormalizationField) Enum() *NormalizationField {
+	p := new(NormalizationField)
+	*p = x
+	return p
+}
+
+func (x NormalizationField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NormalizationField) Descriptor() protoreflect.EnumDescriptor {
+	return file_alm_common_proto_enumTypes[7].Descriptor()
+}
+
+func (NormalizationField) Type() protoreflect.EnumType {
+	return &file_alm_common_proto_enumTypes[7]
+}
+
+func (x NormalizationField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NormalizationField.Descriptor instead.
+func (NormalizationField) EnumDescriptor() ([]byte, []int) {
+	return file_alm_common_proto_rawDescGZIP(), []int{7}
+}
+
+// Operator action on alarms (AlarmActionRequest)
+type AlarmActionType int32
+
+const (
+	AlarmActionType_ALARM_ACTION_TYPE_UNSPECIFIED   AlarmActionType = 0
+	AlarmActionType_ALARM_ACTION_TYPE_ACKNOWLEDGE   AlarmActionType = 1
+	AlarmActionType_ALARM_ACTION_TYPE_UNACKNOWLEDGE AlarmActionType = 2
+	AlarmActionType_ALARM_ACTION_TYPE_CLEAR         AlarmActionType = 3
+	AlarmActionType_ALARM_ACTION_TYPE_ASSIGN        AlarmActionType = 4
+	AlarmActionType_ALARM_ACTION_TYPE_ADD_NOTE      AlarmActionType = 5
+)
+
+// Enum value maps for AlarmActionType.
+var (
+	AlarmActionType_name = map[int32]string{
+		0: "ALARM_ACTION_TYPE_UNSPECIFIED",
+		1: "ALARM_ACTION_TYPE_ACKNOWLEDGE",
+		2: "ALARM_ACTION_TYPE_UNACKNOWLEDGE",
+		3: "ALARM_ACTION_TYPE_CLEAR",
+		4: "ALARM_ACTION_TYPE_ASSIGN",
+		5: "ALARM_ACTION_TYPE_ADD_NOTE",
+	}
+	AlarmActionType_value = map[string]int32{
+		"ALARM_ACTION_TYPE_UNSPECIFIED":   0,
+		"ALARM_ACTION_TYPE_ACKNOWLEDGE":   1,
+		"ALARM_ACTION_TYPE_UNACKNOWLEDGE": 2,
+		"ALARM_ACTION_TYPE_CLEAR":         3,
+		"ALARM_ACTION_TYPE_ASSIGN":        4,
+		"ALARM_ACTION_TYPE_ADD_NOTE":      5,
+	}
+)
+
+func (x AlarmActionType) Enum() *AlarmActionType {
+	p := new(AlarmActionType)
+	*p = x
+	return p
+}
+
+func (x AlarmActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlarmActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_alm_common_proto_enumTypes[8].Descriptor()
+}
+
+func (AlarmActionType) Type() protoreflect.EnumType {
+	return &file_alm_common_proto_enumTypes[8]
+}
+
+func (x AlarmActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlarmActionType.Descriptor instead.
+func (AlarmActionType) EnumDescriptor() ([]byte, []int) {
+	return file_alm_common_proto_rawDescGZIP(), []int{8}
+}
+
 var File_alm_common_proto protoreflect.FileDescriptor
 
 var file_alm_common_proto_rawDesc = []byte{
@@ -505,8 +614,30 @@ var file_alm_common_proto_rawDesc = []byte{
 	0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
 	0x4f, 0x52, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x10, 0x06, 0x12, 0x19,
 	0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52,
-	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x10, 0x07, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2f, 0x61, 0x6c, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x10, 0x07, 0x2a, 0x7c, 0x0a, 0x12, 0x4e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x23, 0x0a, 0x1f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x49, 0x5a,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x49,
+	0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x52, 0x41, 0x57,
+	0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x02, 0x2a, 0xd7, 0x01, 0x0a, 0x0f, 0x41, 0x6c, 0x61, 0x72,
+	0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x41,
+	0x4c, 0x41, 0x52, 0x4d, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21,
+	0x0a, 0x1d, 0x41, 0x4c, 0x41, 0x52, 0x4d, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x4b, 0x4e, 0x4f, 0x57, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x10,
+	0x01, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x4c, 0x41, 0x52, 0x4d, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x43, 0x4b, 0x4e, 0x4f, 0x57, 0x4c,
+	0x45, 0x44, 0x47, 0x45, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x4c, 0x41, 0x52, 0x4d, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4c, 0x45, 0x41,
+	0x52, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x4c, 0x41, 0x52, 0x4d, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x10,
+	0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x4c, 0x41, 0x52, 0x4d, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x10,
+	0x05, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x61, 0x6c, 0x6d,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -521,7 +652,7 @@ func file_alm_common_proto_rawDescGZIP() []byte {
 	return file_alm_common_proto_rawDescData
 }
 
-var file_alm_common_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_alm_common_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
 var file_alm_common_proto_goTypes = []interface{}{
 	(AlarmDefinitionStatus)(0), // 0: alm.AlarmDefinitionStatus
 	(CorrelationRuleType)(0),   // 1: alm.CorrelationRuleType
@@ -530,6 +661,8 @@ var file_alm_common_proto_goTypes = []interface{}{
 	(AlmEventType)(0),          // 4: alm.AlmEventType
 	(TraversalDirection)(0),    // 5: alm.TraversalDirection
 	(ConditionOperator)(0),     // 6: alm.ConditionOperator
+	(NormalizationField)(0),    // 7: alm.NormalizationField
+	(AlarmActionType)(0),       // 8: alm.AlarmActionType
 }
 var file_alm_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -549,7 +682,7 @@ func file_alm_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_alm_common_proto_rawDesc,
-			NumEnums:      7,
+			NumEnums:      9,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -11,7 +11,7 @@ cp "$PROJ_ROOT/l8types/proto/l8events.proto" .
 cp "$PROJ_ROOT/l8notify/proto/l8notify.proto" .
 
 # Generate bindings for all alarms proto files
-docker run --user "$(id -u):$(id -g)" -e PROTO="alm-common.proto alm-definitions.proto alm-alarms.proto alm-events.proto alm-correlation.proto alm-policies.proto alm-maintenance.proto alm-filters.proto alm-archive.proto" --mount type=bind,source="$PWD",target=/home/proto/ -i saichler/protoc:latest
+docker run --user "$(id -u):$(id -g)" -e PROTO="alm-common.proto alm-definitions.proto alm-alarms.proto alm-events.proto alm-correlation.proto alm-policies.proto alm-maintenance.proto alm-filters.proto alm-archive.proto alm-ingest.proto" --mount type=bind,source="$PWD",target=/home/proto/ -i saichler/protoc:latest
 
 # Move generated bindings to the types directory and clean up
 rm -rf ../go/types